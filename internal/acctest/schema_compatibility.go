@@ -0,0 +1,523 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Helpers for verifying that a resource migrated from terraform-plugin-sdk to Terraform Plugin Framework
+// (see `SetMigratedFromPluginSDK`) does not change its configuration or state shape.
+
+// CheckFrameworkSchemaCompatibility fails the test if the schema of the Terraform Plugin Framework resource
+// is not backwards compatible with the schema of the terraform-plugin-sdk resource it was migrated from.
+func CheckFrameworkSchemaCompatibility(ctx context.Context, t *testing.T, sdkResource *schema.Resource, factory func(context.Context) (fwresource.ResourceWithConfigure, error)) {
+	t.Helper()
+
+	for _, err := range FrameworkSchemaIncompatibilities(ctx, sdkResource, frameworkResourceSchema(ctx, t, factory)) {
+		t.Error(err)
+	}
+}
+
+// CheckFrameworkStateRoundTrip fails the test if a sample state, as written by the terraform-plugin-sdk resource,
+// cannot be read by the Terraform Plugin Framework resource it was migrated from without loss.
+// model must be a pointer to the Terraform Plugin Framework resource's data model.
+func CheckFrameworkStateRoundTrip(ctx context.Context, t *testing.T, sdkResource *schema.Resource, factory func(context.Context) (fwresource.ResourceWithConfigure, error), stateJSON string, model any) {
+	t.Helper()
+
+	if err := FrameworkStateRoundTrip(ctx, sdkResource, frameworkResourceSchema(ctx, t, factory), []byte(stateJSON), model); err != nil {
+		t.Error(err)
+	}
+}
+
+func frameworkResourceSchema(ctx context.Context, t *testing.T, factory func(context.Context) (fwresource.ResourceWithConfigure, error)) fwschema.Schema {
+	t.Helper()
+
+	resource, err := factory(ctx)
+
+	if err != nil {
+		t.Fatalf("creating Framework resource: %s", err)
+	}
+
+	response := fwresource.SchemaResponse{}
+	resource.Schema(ctx, fwresource.SchemaRequest{}, &response)
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("reading Framework resource schema: %s", fwdiag.DiagnosticsError(response.Diagnostics))
+	}
+
+	return response.Schema
+}
+
+// FrameworkSchemaIncompatibilities compares the schema of a terraform-plugin-sdk resource with that of the
+// Terraform Plugin Framework resource it has been migrated to and returns any incompatibilities found.
+// Attribute names, types, block nesting modes, Optional/Required/Computed flags and default values are compared.
+// Attributes or blocks added as Optional or Computed in the Framework schema are not considered incompatible.
+func FrameworkSchemaIncompatibilities(ctx context.Context, sdkResource *schema.Resource, fwSchema fwschema.Schema) []error {
+	sdk := sdkResourceShape(sdkResource)
+
+	// The implicit "id" attribute.
+	if _, ok := sdk.attributes[names.AttrID]; !ok {
+		sdk.attributes[names.AttrID] = attributeShape{
+			typ:      tftypes.String,
+			optional: true,
+			computed: true,
+		}
+	}
+
+	// The implicit "timeouts" block.
+	if v := sdkResource.Timeouts; v != nil {
+		if _, ok := sdk.blocks[schema.TimeoutsConfigKey]; !ok {
+			timeouts := blockShape{
+				nesting: nestingModeSingle,
+				shape: schemaShape{
+					attributes: make(map[string]attributeShape),
+					blocks:     make(map[string]blockShape),
+				},
+			}
+
+			for name, ok := range map[string]bool{
+				schema.TimeoutCreate:  v.Create != nil,
+				schema.TimeoutRead:    v.Read != nil,
+				schema.TimeoutUpdate:  v.Update != nil,
+				schema.TimeoutDelete:  v.Delete != nil,
+				schema.TimeoutDefault: v.Default != nil,
+			} {
+				if ok {
+					timeouts.shape.attributes[name] = attributeShape{
+						typ:      tftypes.String,
+						optional: true,
+					}
+				}
+			}
+
+			sdk.blocks[schema.TimeoutsConfigKey] = timeouts
+		}
+	}
+
+	fw, errs := frameworkObjectShape(ctx, "", fwSchema.Attributes, fwSchema.Blocks)
+
+	return append(errs, compareSchemaShapes("", sdk, fw)...)
+}
+
+// FrameworkStateRoundTrip verifies that a sample state, as written by a terraform-plugin-sdk resource,
+// can be decoded using the schema of the Terraform Plugin Framework resource it has been migrated to,
+// read into the resource's data model and written back out without changes.
+// model must be a pointer to the Terraform Plugin Framework resource's data model.
+func FrameworkStateRoundTrip(ctx context.Context, sdkResource *schema.Resource, fwSchema fwschema.Schema, stateJSON []byte, model any) error {
+	if _, err := ctyjson.Unmarshal(stateJSON, sdkResource.CoreConfigSchema().ImpliedType()); err != nil {
+		return fmt.Errorf("decoding state using SDK schema: %w", err)
+	}
+
+	typ := fwSchema.Type().TerraformType(ctx)
+
+	// Mirror the Framework's handling of UpgradeResourceState when the schema version is unchanged.
+	raw, err := (&tfprotov5.RawState{JSON: stateJSON}).UnmarshalWithOpts(typ, tfprotov5.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+			IgnoreUndefinedAttributes: true,
+		},
+	})
+
+	if err != nil {
+		return fmt.Errorf("decoding state using Framework schema: %w", err)
+	}
+
+	state := tfsdk.State{
+		Raw:    raw,
+		Schema: fwSchema,
+	}
+
+	if err := fwdiag.DiagnosticsError(state.Get(ctx, model)); err != nil {
+		return fmt.Errorf("reading state into data model: %w", err)
+	}
+
+	output := tfsdk.State{
+		Raw:    tftypes.NewValue(typ, nil),
+		Schema: fwSchema,
+	}
+
+	if err := fwdiag.DiagnosticsError(output.Set(ctx, model)); err != nil {
+		return fmt.Errorf("writing data model to state: %w", err)
+	}
+
+	diffs, err := raw.Diff(output.Raw)
+
+	if err != nil {
+		return fmt.Errorf("comparing states: %w", err)
+	}
+
+	if len(diffs) > 0 {
+		paths := make([]string, 0, len(diffs))
+		for _, diff := range diffs {
+			paths = append(paths, diff.Path.String())
+		}
+		sort.Strings(paths)
+
+		return fmt.Errorf("state changed after round trip through data model: %s", strings.Join(paths, ", "))
+	}
+
+	return nil
+}
+
+const (
+	nestingModeList   = "list"
+	nestingModeSet    = "set"
+	nestingModeSingle = "single"
+)
+
+// schemaShape is the state-affecting subset of a schema, common to terraform-plugin-sdk and Terraform Plugin Framework.
+type schemaShape struct {
+	attributes map[string]attributeShape
+	blocks     map[string]blockShape
+}
+
+type attributeShape struct {
+	typ                          tftypes.Type
+	optional, required, computed bool
+	defaultValue                 *tftypes.Value
+}
+
+type blockShape struct {
+	nesting string
+	shape   schemaShape
+}
+
+func sdkResourceShape(r *schema.Resource) schemaShape {
+	shape := schemaShape{
+		attributes: make(map[string]attributeShape),
+		blocks:     make(map[string]blockShape),
+	}
+
+	for name, s := range r.SchemaMap() {
+		if sdkSchemaIsBlock(s) {
+			nesting := nestingModeList
+			if s.Type == schema.TypeSet {
+				nesting = nestingModeSet
+			}
+
+			shape.blocks[name] = blockShape{
+				nesting: nesting,
+				shape:   sdkResourceShape(s.Elem.(*schema.Resource)),
+			}
+
+			continue
+		}
+
+		attribute := attributeShape{
+			typ:      sdkSchemaType(s),
+			optional: s.Optional,
+			required: s.Required,
+			computed: s.Computed,
+		}
+
+		if v := s.Default; v != nil {
+			if v, ok := sdkDefaultValue(s.Type, v); ok {
+				attribute.defaultValue = &v
+			}
+		}
+
+		shape.attributes[name] = attribute
+	}
+
+	return shape
+}
+
+// sdkSchemaIsBlock mirrors the rules used by terraform-plugin-sdk to represent a schema as a nested block.
+func sdkSchemaIsBlock(s *schema.Schema) bool {
+	if _, ok := s.Elem.(*schema.Resource); !ok || s.Type == schema.TypeMap {
+		return false
+	}
+
+	switch s.ConfigMode {
+	case schema.SchemaConfigModeAttr:
+		return false
+	case schema.SchemaConfigModeBlock:
+		return true
+	default:
+		return !s.Computed || s.Optional
+	}
+}
+
+func sdkSchemaType(s *schema.Schema) tftypes.Type {
+	switch s.Type {
+	case schema.TypeBool:
+		return tftypes.Bool
+	case schema.TypeInt, schema.TypeFloat:
+		return tftypes.Number
+	case schema.TypeString:
+		return tftypes.String
+	case schema.TypeList, schema.TypeSet, schema.TypeMap:
+		var elemType tftypes.Type = tftypes.String
+
+		switch v := s.Elem.(type) {
+		case *schema.Schema:
+			elemType = sdkSchemaType(v)
+		case schema.ValueType:
+			elemType = sdkSchemaType(&schema.Schema{Type: v})
+		case *schema.Resource:
+			if s.Type != schema.TypeMap {
+				elemType = sdkResourceObjectType(v)
+			}
+		}
+
+		switch s.Type {
+		case schema.TypeList:
+			return tftypes.List{ElementType: elemType}
+		case schema.TypeSet:
+			return tftypes.Set{ElementType: elemType}
+		default:
+			return tftypes.Map{ElementType: elemType}
+		}
+	}
+
+	return nil
+}
+
+func sdkResourceObjectType(r *schema.Resource) tftypes.Type {
+	attributeTypes := make(map[string]tftypes.Type)
+
+	for name, s := range r.SchemaMap() {
+		attributeTypes[name] = sdkSchemaType(s)
+	}
+
+	return tftypes.Object{AttributeTypes: attributeTypes}
+}
+
+func sdkDefaultValue(t schema.ValueType, v any) (tftypes.Value, bool) {
+	switch t {
+	case schema.TypeBool:
+		if v, ok := v.(bool); ok {
+			return tftypes.NewValue(tftypes.Bool, v), true
+		}
+	case schema.TypeInt:
+		if v, ok := v.(int); ok {
+			return tftypes.NewValue(tftypes.Number, big.NewFloat(float64(v))), true
+		}
+	case schema.TypeFloat:
+		if v, ok := v.(float64); ok {
+			return tftypes.NewValue(tftypes.Number, big.NewFloat(v)), true
+		}
+	case schema.TypeString:
+		if v, ok := v.(string); ok {
+			return tftypes.NewValue(tftypes.String, v), true
+		}
+	}
+
+	return tftypes.Value{}, false
+}
+
+func frameworkObjectShape(ctx context.Context, path string, attributes map[string]fwschema.Attribute, blocks map[string]fwschema.Block) (schemaShape, []error) {
+	var errs []error
+	shape := schemaShape{
+		attributes: make(map[string]attributeShape),
+		blocks:     make(map[string]blockShape),
+	}
+
+	for name, a := range attributes {
+		attribute := attributeShape{
+			typ:      a.GetType().TerraformType(ctx),
+			optional: a.IsOptional(),
+			required: a.IsRequired(),
+			computed: a.IsComputed(),
+		}
+
+		if v, ok := frameworkDefaultValue(ctx, a); ok {
+			attribute.defaultValue = &v
+		}
+
+		shape.attributes[name] = attribute
+	}
+
+	for name, b := range blocks {
+		var block blockShape
+		var nestedErrs []error
+
+		switch b := b.(type) {
+		case fwschema.ListNestedBlock:
+			block.nesting = nestingModeList
+			block.shape, nestedErrs = frameworkObjectShape(ctx, joinSchemaPath(path, name), b.NestedObject.Attributes, b.NestedObject.Blocks)
+		case fwschema.SetNestedBlock:
+			block.nesting = nestingModeSet
+			block.shape, nestedErrs = frameworkObjectShape(ctx, joinSchemaPath(path, name), b.NestedObject.Attributes, b.NestedObject.Blocks)
+		case fwschema.SingleNestedBlock:
+			block.nesting = nestingModeSingle
+			block.shape, nestedErrs = frameworkObjectShape(ctx, joinSchemaPath(path, name), b.Attributes, b.Blocks)
+		default:
+			errs = append(errs, fmt.Errorf("%s: unsupported Framework block type %T", joinSchemaPath(path, name), b))
+			continue
+		}
+
+		errs = append(errs, nestedErrs...)
+		shape.blocks[name] = block
+	}
+
+	return shape, errs
+}
+
+func frameworkDefaultValue(ctx context.Context, a fwschema.Attribute) (tftypes.Value, bool) {
+	var v interface {
+		ToTerraformValue(context.Context) (tftypes.Value, error)
+	}
+
+	switch a := a.(type) {
+	case fwschema.BoolAttribute:
+		if a.Default == nil {
+			return tftypes.Value{}, false
+		}
+		response := defaults.BoolResponse{}
+		a.Default.DefaultBool(ctx, defaults.BoolRequest{}, &response)
+		v = response.PlanValue
+	case fwschema.Float64Attribute:
+		if a.Default == nil {
+			return tftypes.Value{}, false
+		}
+		response := defaults.Float64Response{}
+		a.Default.DefaultFloat64(ctx, defaults.Float64Request{}, &response)
+		v = response.PlanValue
+	case fwschema.Int64Attribute:
+		if a.Default == nil {
+			return tftypes.Value{}, false
+		}
+		response := defaults.Int64Response{}
+		a.Default.DefaultInt64(ctx, defaults.Int64Request{}, &response)
+		v = response.PlanValue
+	case fwschema.StringAttribute:
+		if a.Default == nil {
+			return tftypes.Value{}, false
+		}
+		response := defaults.StringResponse{}
+		a.Default.DefaultString(ctx, defaults.StringRequest{}, &response)
+		v = response.PlanValue
+	default:
+		return tftypes.Value{}, false
+	}
+
+	tfv, err := v.ToTerraformValue(ctx)
+
+	if err != nil {
+		return tftypes.Value{}, false
+	}
+
+	return tfv, true
+}
+
+func compareSchemaShapes(path string, sdk, fw schemaShape) []error {
+	var errs []error
+
+	for _, name := range sortedKeys(sdk.attributes) {
+		p := joinSchemaPath(path, name)
+		old := sdk.attributes[name]
+		new, ok := fw.attributes[name]
+
+		if !ok {
+			if _, ok := fw.blocks[name]; ok {
+				errs = append(errs, fmt.Errorf("%s: attribute changed to block", p))
+			} else {
+				errs = append(errs, fmt.Errorf("%s: attribute removed", p))
+			}
+			continue
+		}
+
+		if !old.typ.Equal(new.typ) {
+			errs = append(errs, fmt.Errorf("%s: type changed from %s to %s", p, old.typ, new.typ))
+		}
+
+		// The implicit "id" attribute is always Optional+Computed in terraform-plugin-sdk.
+		if p == names.AttrID {
+			continue
+		}
+
+		if old.optional != new.optional {
+			errs = append(errs, fmt.Errorf("%s: Optional changed from %t to %t", p, old.optional, new.optional))
+		}
+		if old.required != new.required {
+			errs = append(errs, fmt.Errorf("%s: Required changed from %t to %t", p, old.required, new.required))
+		}
+		// Terraform Plugin Framework requires attributes with a default value to be Computed.
+		if old.computed != new.computed && !(old.defaultValue != nil && new.defaultValue != nil) {
+			errs = append(errs, fmt.Errorf("%s: Computed changed from %t to %t", p, old.computed, new.computed))
+		}
+
+		switch {
+		case old.defaultValue == nil && new.defaultValue != nil:
+			errs = append(errs, fmt.Errorf("%s: default value %s added", p, new.defaultValue))
+		case old.defaultValue != nil && new.defaultValue == nil:
+			errs = append(errs, fmt.Errorf("%s: default value %s removed", p, old.defaultValue))
+		case old.defaultValue != nil && new.defaultValue != nil && !old.defaultValue.Equal(*new.defaultValue):
+			errs = append(errs, fmt.Errorf("%s: default value changed from %s to %s", p, old.defaultValue, new.defaultValue))
+		}
+	}
+
+	for _, name := range sortedKeys(fw.attributes) {
+		if _, ok := sdk.attributes[name]; ok {
+			continue
+		}
+
+		p := joinSchemaPath(path, name)
+
+		if _, ok := sdk.blocks[name]; ok {
+			errs = append(errs, fmt.Errorf("%s: block changed to attribute", p))
+		} else if fw.attributes[name].required {
+			errs = append(errs, fmt.Errorf("%s: Required attribute added", p))
+		}
+	}
+
+	for _, name := range sortedKeys(sdk.blocks) {
+		p := joinSchemaPath(path, name)
+		old := sdk.blocks[name]
+		new, ok := fw.blocks[name]
+
+		if !ok {
+			if _, ok := fw.attributes[name]; !ok {
+				errs = append(errs, fmt.Errorf("%s: block removed", p))
+			}
+			continue
+		}
+
+		if old.nesting != new.nesting {
+			errs = append(errs, fmt.Errorf("%s: nesting mode changed from %s to %s", p, old.nesting, new.nesting))
+			continue
+		}
+
+		errs = append(errs, compareSchemaShapes(p, old.shape, new.shape)...)
+	}
+
+	return errs
+}
+
+func joinSchemaPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,365 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccSchemaCompatibilitySDKResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "STANDARD",
+			},
+		},
+	}
+}
+
+func testAccSchemaCompatibilityFrameworkSchema(ctx context.Context) fwschema.Schema {
+	return fwschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"arn": fwschema.StringAttribute{
+				Computed: true,
+			},
+			"id": fwschema.StringAttribute{
+				Computed: true,
+			},
+			"name": fwschema.StringAttribute{
+				Required: true,
+			},
+			"size": fwschema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+			},
+			"type": fwschema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("STANDARD"),
+			},
+		},
+		Blocks: map[string]fwschema.Block{
+			"configuration": fwschema.ListNestedBlock{
+				NestedObject: fwschema.NestedBlockObject{
+					Attributes: map[string]fwschema.Attribute{
+						"enabled": fwschema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(true),
+						},
+						"values": fwschema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+type testAccSchemaCompatibilityConfigurationModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+	Values  types.Set  `tfsdk:"values"`
+}
+
+type testAccSchemaCompatibilityModel struct {
+	ARN           types.String                                   `tfsdk:"arn"`
+	Configuration []testAccSchemaCompatibilityConfigurationModel `tfsdk:"configuration"`
+	ID            types.String                                   `tfsdk:"id"`
+	Name          types.String                                   `tfsdk:"name"`
+	Size          types.Int64                                    `tfsdk:"size"`
+	Timeouts      timeouts.Value                                 `tfsdk:"timeouts"`
+	Type          types.String                                   `tfsdk:"type"`
+}
+
+func TestFrameworkSchemaIncompatibilities(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		name     string
+		modify   func(*fwschema.Schema)
+		expected []string
+	}{
+		{
+			name:   "compatible",
+			modify: func(*fwschema.Schema) {},
+		},
+		{
+			name: "optional attribute added",
+			modify: func(s *fwschema.Schema) {
+				s.Attributes["description"] = fwschema.StringAttribute{
+					Optional: true,
+				}
+			},
+		},
+		{
+			name: "required attribute added",
+			modify: func(s *fwschema.Schema) {
+				s.Attributes["description"] = fwschema.StringAttribute{
+					Required: true,
+				}
+			},
+			expected: []string{
+				"description: Required attribute added",
+			},
+		},
+		{
+			name: "attribute removed",
+			modify: func(s *fwschema.Schema) {
+				delete(s.Attributes, "arn")
+			},
+			expected: []string{
+				"arn: attribute removed",
+			},
+		},
+		{
+			name: "attribute type changed",
+			modify: func(s *fwschema.Schema) {
+				s.Attributes["size"] = fwschema.StringAttribute{
+					Optional: true,
+					Computed: true,
+				}
+			},
+			expected: []string{
+				"size: type changed from tftypes.Number to tftypes.String",
+			},
+		},
+		{
+			name: "attribute flags changed",
+			modify: func(s *fwschema.Schema) {
+				s.Attributes["name"] = fwschema.StringAttribute{
+					Optional: true,
+					Computed: true,
+				}
+			},
+			expected: []string{
+				"name: Optional changed from false to true",
+				"name: Required changed from true to false",
+				"name: Computed changed from false to true",
+			},
+		},
+		{
+			name: "default value changed",
+			modify: func(s *fwschema.Schema) {
+				s.Attributes["type"] = fwschema.StringAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString("PREMIUM"),
+				}
+			},
+			expected: []string{
+				`type: default value changed from tftypes.String<"STANDARD"> to tftypes.String<"PREMIUM">`,
+			},
+		},
+		{
+			name: "block nesting mode changed",
+			modify: func(s *fwschema.Schema) {
+				s.Blocks["configuration"] = fwschema.SingleNestedBlock{
+					Attributes: s.Blocks["configuration"].(fwschema.ListNestedBlock).NestedObject.Attributes,
+				}
+			},
+			expected: []string{
+				"configuration: nesting mode changed from list to single",
+			},
+		},
+		{
+			name: "block changed to attribute",
+			modify: func(s *fwschema.Schema) {
+				delete(s.Blocks, "configuration")
+				s.Attributes["configuration"] = fwschema.ListNestedAttribute{
+					NestedObject: fwschema.NestedAttributeObject{
+						Attributes: map[string]fwschema.Attribute{
+							"enabled": fwschema.BoolAttribute{
+								Optional: true,
+							},
+							"values": fwschema.SetAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+							},
+						},
+					},
+					Optional: true,
+				}
+			},
+			expected: []string{
+				"configuration: block changed to attribute",
+			},
+		},
+		{
+			name: "nested attribute changed",
+			modify: func(s *fwschema.Schema) {
+				s.Blocks["configuration"] = fwschema.ListNestedBlock{
+					NestedObject: fwschema.NestedBlockObject{
+						Attributes: map[string]fwschema.Attribute{
+							"enabled": fwschema.BoolAttribute{
+								Optional: true,
+							},
+							"values": fwschema.ListAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+							},
+						},
+					},
+				}
+			},
+			expected: []string{
+				"configuration.enabled: default value tftypes.Bool<\"true\"> removed",
+				"configuration.values: type changed from tftypes.Set[tftypes.String] to tftypes.List[tftypes.String]",
+			},
+		},
+		{
+			name: "timeouts removed",
+			modify: func(s *fwschema.Schema) {
+				delete(s.Blocks, "timeouts")
+			},
+			expected: []string{
+				"timeouts: block removed",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			fwSchema := testAccSchemaCompatibilityFrameworkSchema(ctx)
+			testCase.modify(&fwSchema)
+
+			var got []string
+			for _, err := range acctest.FrameworkSchemaIncompatibilities(ctx, testAccSchemaCompatibilitySDKResource(), fwSchema) {
+				got = append(got, err.Error())
+			}
+
+			if g, e := strings.Join(got, "\n"), strings.Join(testCase.expected, "\n"); g != e {
+				t.Errorf("got incompatibilities:\n%s\nexpected:\n%s", g, e)
+			}
+		})
+	}
+}
+
+func TestFrameworkStateRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		name          string
+		stateJSON     string
+		expectedError string
+	}{
+		{
+			name: "full",
+			stateJSON: `{
+  "arn": "arn:aws:example:us-west-2:123456789012:thing/test",
+  "configuration": [{"enabled": false, "values": ["a", "b"]}],
+  "id": "test",
+  "name": "test",
+  "size": 3,
+  "timeouts": null,
+  "type": "STANDARD"
+}`,
+		},
+		{
+			name: "empty block",
+			stateJSON: `{
+  "arn": "arn:aws:example:us-west-2:123456789012:thing/test",
+  "configuration": [],
+  "id": "test",
+  "name": "test",
+  "size": 3,
+  "timeouts": null,
+  "type": "STANDARD"
+}`,
+		},
+		{
+			name: "not SDK state",
+			stateJSON: `{
+  "configuration": {"enabled": false},
+  "id": "test",
+  "name": "test"
+}`,
+			expectedError: "decoding state using SDK schema",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var model testAccSchemaCompatibilityModel
+			err := acctest.FrameworkStateRoundTrip(ctx, testAccSchemaCompatibilitySDKResource(), testAccSchemaCompatibilityFrameworkSchema(ctx), []byte(testCase.stateJSON), &model)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+			} else {
+				if err == nil {
+					t.Errorf("expected error containing %q, got none", testCase.expectedError)
+				} else if !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Errorf("expected error containing %q, got %q", testCase.expectedError, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// JSONType is a string type whose values must be valid JSON.
+// Values are semantically equal if they represent the same JSON document, ignoring insignificant whitespace and object key order.
+type JSONType struct {
+	basetypes.StringType
+}
+
+var (
+	_ basetypes.StringTypable = JSONType{}
+	_ xattr.TypeWithValidate  = JSONType{}
+)
+
+func (typ JSONType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return JSONValue{StringValue: in}, nil
+}
+
+func (typ JSONType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := typ.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return JSONValue{StringValue: stringValue}, nil
+}
+
+func (typ JSONType) ValueType(context.Context) attr.Value {
+	return JSONValue{}
+}
+
+func (typ JSONType) Equal(o attr.Type) bool {
+	other, ok := o.(JSONType)
+	if !ok {
+		return false
+	}
+
+	return typ.StringType.Equal(other.StringType)
+}
+
+// String returns a human-friendly description of the JSONType.
+func (typ JSONType) String() string {
+	return "types.JSONType"
+}
+
+func (typ JSONType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var s string
+	err := in.As(&s)
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"Invalid Terraform Value",
+			"An unexpected error occurred while attempting to convert a Terraform value to a string. "+
+				"This is generally an issue with the provider schema implementation. "+
+				"Please report the following to the provider developer:\n\n"+
+				"Path: "+path.String()+"\n"+
+				"Error: "+err.Error(),
+		)
+		return diags
+	}
+
+	if !json.Valid([]byte(s)) {
+		diags.AddAttributeError(
+			path,
+			"Invalid JSON String Value",
+			fmt.Sprintf("Value %q is not a valid JSON string.\n\n"+
+				"Path: %s", s, path),
+		)
+		return diags
+	}
+
+	return diags
+}
+
+func NewJSONNull() JSONValue {
+	return JSONValue{StringValue: basetypes.NewStringNull()}
+}
+
+func NewJSONUnknown() JSONValue {
+	return JSONValue{StringValue: basetypes.NewStringUnknown()}
+}
+
+func NewJSONValue(value string) JSONValue {
+	return JSONValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func NewJSONPointerValue(value *string) JSONValue {
+	return JSONValue{StringValue: basetypes.NewStringPointerValue(value)}
+}
+
+var (
+	_ basetypes.StringValuable                   = JSONValue{}
+	_ basetypes.StringValuableWithSemanticEquals = JSONValue{}
+)
+
+type JSONValue struct {
+	basetypes.StringValue
+}
+
+func (val JSONValue) Type(_ context.Context) attr.Type {
+	return JSONType{}
+}
+
+func (val JSONValue) Equal(other attr.Value) bool {
+	o, ok := other.(JSONValue)
+
+	if !ok {
+		return false
+	}
+
+	return val.StringValue.Equal(o.StringValue)
+}
+
+// StringSemanticEquals returns true if the given JSON value represents the same JSON document as the current value.
+func (val JSONValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(JSONValue)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", val)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	var old, new any
+
+	if err := json.Unmarshal([]byte(val.ValueString()), &old); err != nil {
+		diags.AddError("Semantic Equality Check Error", err.Error())
+
+		return false, diags
+	}
+
+	if err := json.Unmarshal([]byte(newValue.ValueString()), &new); err != nil {
+		diags.AddError("Semantic Equality Check Error", err.Error())
+
+		return false, diags
+	}
+
+	return reflect.DeepEqual(old, new), diags
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestJSONTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         tftypes.Value
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"not a string": {
+			val:         tftypes.NewValue(tftypes.Bool, true),
+			expectError: true,
+		},
+		"unknown string": {
+			val: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"null string": {
+			val: tftypes.NewValue(tftypes.String, nil),
+		},
+		"valid JSON": {
+			val: tftypes.NewValue(tftypes.String, `{"a": [1, 2, 3]}`),
+		},
+		"invalid JSON": {
+			val:         tftypes.NewValue(tftypes.String, `{"a": `),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			diags := fwtypes.JSONType{}.Validate(ctx, test.val, path.Root("test"))
+
+			if !diags.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if diags.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %#v", diags)
+			}
+		})
+	}
+}
+
+func TestJSONValueStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val1, val2 fwtypes.JSONValue
+		equals     bool
+	}
+	tests := map[string]testCase{
+		"identical": {
+			val1:   fwtypes.NewJSONValue(`{"a":1}`),
+			val2:   fwtypes.NewJSONValue(`{"a":1}`),
+			equals: true,
+		},
+		"whitespace": {
+			val1:   fwtypes.NewJSONValue(`{"a":1,"b":[true,null]}`),
+			val2:   fwtypes.NewJSONValue("{\n  \"a\": 1,\n  \"b\": [ true, null ]\n}"),
+			equals: true,
+		},
+		"key order": {
+			val1:   fwtypes.NewJSONValue(`{"a":1,"b":{"c":"d","e":"f"}}`),
+			val2:   fwtypes.NewJSONValue(`{"b":{"e":"f","c":"d"},"a":1}`),
+			equals: true,
+		},
+		"different value": {
+			val1: fwtypes.NewJSONValue(`{"a":1}`),
+			val2: fwtypes.NewJSONValue(`{"a":2}`),
+		},
+		"array order": {
+			val1: fwtypes.NewJSONValue(`[1,2]`),
+			val2: fwtypes.NewJSONValue(`[2,1]`),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, diags := test.val1.StringSemanticEquals(ctx, test.val2)
+
+			if diags.HasError() {
+				t.Fatalf("got unexpected error: %#v", diags)
+			}
+
+			if got, want := equals, test.equals; got != want {
+				t.Errorf("StringSemanticEquals(%s, %s) = %t, want %t", test.val1, test.val2, got, want)
+			}
+		})
+	}
+}
@@ -13,11 +13,13 @@ import (
 	"fmt"
 	"go/ast"
 	"go/format"
+	"go/types"
 	"html/template"
 	"log"
 	"os"
 	"sort"
 	"strings"
+	texttemplate "text/template"
 
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"golang.org/x/tools/go/packages"
@@ -25,6 +27,9 @@ import (
 
 const (
 	defaultFilename = "list_pages_gen.go"
+
+	sdkV1 = 1
+	sdkV2 = 2
 )
 
 var (
@@ -33,6 +38,7 @@ var (
 	outputPaginator = flag.String("OutputPaginator", "", "name of the output pagination token field")
 	paginator       = flag.String("Paginator", "NextToken", "name of the pagination token field")
 	export          = flag.Bool("Export", false, "whether to export the list functions")
+	sdkVersion      = flag.Int("AWSSDKVersion", sdkV1, "Version of the AWS Go SDK to use i.e. 1 or 2")
 )
 
 func usage() {
@@ -67,6 +73,19 @@ func main() {
 	servicePackage := os.Getenv("GOPACKAGE")
 	log.SetPrefix(fmt.Sprintf("generate/listpage: %s: ", servicePackage))
 
+	switch *sdkVersion {
+	case sdkV1:
+	case sdkV2:
+		if *paginator != "NextToken" || *inputPaginator != *paginator || *outputPaginator != *paginator {
+			log.Fatal("pagination token fields are not supported with AWS SDK Go v2, paginators are used instead")
+		}
+
+		generateV2(servicePackage, filename)
+		return
+	default:
+		log.Fatalf("AWS SDK Go Version %d not supported", *sdkVersion)
+	}
+
 	awsService, err := names.AWSGoV1Package(servicePackage)
 
 	if err != nil {
@@ -364,3 +383,209 @@ func fixSomeInitialisms(s string) string {
 
 	return replace
 }
+
+type HeaderInfoV2 struct {
+	Parameters         string
+	DestinationPackage string
+	SourcePackage      string
+	SourceTypesPackage string
+	ImportTypes        bool
+}
+
+type FuncSpecV2 struct {
+	Name           string
+	FindName       string
+	FindSingleName string
+	AWSName        string
+	ClientType     string
+	ParamType      string
+	ResultType     string
+	PaginatorFunc  string
+	ItemsField     string
+	ItemType       string
+}
+
+// generateV2 generates finders over the AWS SDK for Go v2 paginators of the -ListOps operations.
+// Each operation is either "<function-name>" or "<function-name>:<items-field>".
+func generateV2(servicePackage, filename string) {
+	awsService, err := names.AWSGoV2Package(servicePackage)
+
+	if err != nil {
+		log.Fatalf("encountered: %s", err)
+	}
+
+	sourcePackage := fmt.Sprintf("github.com/aws/aws-sdk-go-v2/service/%[1]s", awsService)
+	sourceTypesPackage := sourcePackage + "/types"
+
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes,
+	}
+	pkgs, err := packages.Load(cfg, sourcePackage)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(pkgs) != 1 {
+		log.Fatalf("error: %d packages found", len(pkgs))
+	}
+	pkg := pkgs[0]
+
+	qualifier := func(p *types.Package) string {
+		switch p.Path() {
+		case sourcePackage:
+			return pkg.Name
+		case sourceTypesPackage:
+			return "awstypes"
+		}
+		return p.Name()
+	}
+
+	ops := strings.Split(*listOps, ",")
+	sort.Strings(ops)
+
+	// Generated Go source isn't HTML, so text/template is used.
+	g := Generator{}
+	tmpl := texttemplate.Must(texttemplate.New("function").Parse(functionV2Template))
+
+	var specs []FuncSpecV2
+	importTypes := false
+
+	for _, op := range ops {
+		functionName, itemsField, _ := strings.Cut(op, ":")
+
+		if pkg.Types.Scope().Lookup("New"+functionName+"Paginator") == nil {
+			log.Fatalf("paginator for \"%s\" not found", functionName)
+		}
+
+		output, ok := pkg.Types.Scope().Lookup(functionName + "Output").(*types.TypeName)
+		if !ok {
+			log.Fatalf("output type for \"%s\" not found", functionName)
+		}
+
+		field, err := itemsFieldOf(output.Type().Underlying().(*types.Struct), itemsField)
+		if err != nil {
+			log.Fatalf("function \"%s\": %s", functionName, err)
+		}
+
+		itemType := types.TypeString(field.Type().(*types.Slice).Elem(), qualifier)
+		if strings.Contains(itemType, "awstypes.") {
+			importTypes = true
+		}
+
+		name := functionName
+		if !*export {
+			name = fmt.Sprintf("%s%s", strings.ToLower(name[0:1]), name[1:])
+		}
+		name = fixSomeInitialisms(name)
+
+		plural, singular := findNames(functionName)
+		findName, findSingleName := "find"+plural, "find"+singular
+		if *export {
+			findName, findSingleName = "Find"+plural, "Find"+singular
+		}
+
+		specs = append(specs, FuncSpecV2{
+			Name:           name,
+			FindName:       findName,
+			FindSingleName: findSingleName,
+			AWSName:        functionName,
+			ClientType:     fmt.Sprintf("*%s.Client", pkg.Name),
+			ParamType:      fmt.Sprintf("*%s.%sInput", pkg.Name, functionName),
+			ResultType:     fmt.Sprintf("*%s.%sOutput", pkg.Name, functionName),
+			PaginatorFunc:  fmt.Sprintf("%s.New%sPaginator", pkg.Name, functionName),
+			ItemsField:     field.Name(),
+			ItemType:       itemType,
+		})
+	}
+
+	header := texttemplate.Must(texttemplate.New("header").Parse(headerV2Template))
+	err = header.Execute(&g.buf, HeaderInfoV2{
+		Parameters:         strings.Join(os.Args[1:], " "),
+		DestinationPackage: servicePackage,
+		SourcePackage:      sourcePackage,
+		SourceTypesPackage: sourceTypesPackage,
+		ImportTypes:        importTypes,
+	})
+	if err != nil {
+		log.Fatalf("error writing header: %s", err)
+	}
+
+	for _, spec := range specs {
+		if err := tmpl.Execute(&g.buf, spec); err != nil {
+			log.Fatalf("error writing function \"%s\": %s", spec.AWSName, err)
+		}
+	}
+
+	src := g.format()
+
+	err = os.WriteFile(filename, src, 0644)
+	if err != nil {
+		log.Fatalf("error writing output: %s", err)
+	}
+}
+
+// itemsFieldOf returns the named slice field of an operation output or, if no name is specified, its only slice field.
+func itemsFieldOf(output *types.Struct, name string) (*types.Var, error) {
+	var fields []*types.Var
+
+	for i := 0; i < output.NumFields(); i++ {
+		field := output.Field(i)
+
+		if !field.Exported() {
+			continue
+		}
+
+		if _, ok := field.Type().(*types.Slice); !ok {
+			continue
+		}
+
+		if name == "" || field.Name() == name {
+			fields = append(fields, field)
+		}
+	}
+
+	switch len(fields) {
+	case 0:
+		if name != "" {
+			return nil, fmt.Errorf("slice field \"%s\" not found in output", name)
+		}
+		return nil, fmt.Errorf("no slice fields found in output")
+	case 1:
+		return fields[0], nil
+	default:
+		return nil, fmt.Errorf("multiple slice fields found in output, specify one as <function-name>:<items-field>")
+	}
+}
+
+// findNames returns the names of the plural and singular finders for an operation, e.g. ListClusters => Clusters, Cluster.
+func findNames(functionName string) (string, string) {
+	plural := functionName
+	for _, prefix := range []string{"List", "Describe", "Get", "Search"} {
+		if v := strings.TrimPrefix(functionName, prefix); v != functionName && v != "" {
+			plural = v
+			break
+		}
+	}
+	plural = fixSomeInitialisms(plural)
+
+	var singular string
+	switch {
+	case strings.HasSuffix(plural, "ies"):
+		singular = strings.TrimSuffix(plural, "ies") + "y"
+	case strings.HasSuffix(plural, "sses"), strings.HasSuffix(plural, "xes"):
+		singular = strings.TrimSuffix(plural, "es")
+	case strings.HasSuffix(plural, "IDs"):
+		singular = strings.TrimSuffix(plural, "s")
+	case strings.HasSuffix(plural, "s") && !strings.HasSuffix(plural, "ss"):
+		singular = strings.TrimSuffix(plural, "s")
+	default:
+		singular, plural = plural, plural+"s"
+	}
+
+	return plural, singular
+}
+
+//go:embed header_v2.tmpl
+var headerV2Template string
+
+//go:embed function_v2.tmpl
+var functionV2Template string
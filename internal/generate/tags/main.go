@@ -33,8 +33,10 @@ const (
 
 var (
 	createTags               = flag.Bool("CreateTags", false, "whether to generate CreateTags")
+	framework                = flag.Bool("Framework", false, "whether to generate Terraform Plugin Framework tags helpers")
 	getTag                   = flag.Bool("GetTag", false, "whether to generate GetTag")
 	listTags                 = flag.Bool("ListTags", false, "whether to generate ListTags")
+	listTagsOpPaginated      = flag.Bool("ListTagsOpPaginated", false, "whether ListTagsOp is paginated")
 	serviceTagsMap           = flag.Bool("ServiceTagsMap", false, "whether to generate service tags for map")
 	serviceTagsSlice         = flag.Bool("ServiceTagsSlice", false, "whether to generate service tags for slice")
 	untagInNeedTagType       = flag.Bool("UntagInNeedTagType", false, "whether Untag input needs tag type")
@@ -101,6 +103,7 @@ func usage() {
 }
 
 type TemplateBody struct {
+	framework          string
 	getTag             string
 	header             string
 	listTags           string
@@ -114,6 +117,7 @@ func newTemplateBody(version int, kvtValues bool) *TemplateBody {
 	switch version {
 	case sdkV1:
 		return &TemplateBody{
+			"",
 			"\n" + v1.GetTagBody,
 			v1.HeaderBody,
 			"\n" + v1.ListTagsBody,
@@ -125,6 +129,7 @@ func newTemplateBody(version int, kvtValues bool) *TemplateBody {
 	case sdkV2:
 		if kvtValues {
 			return &TemplateBody{
+				"\n" + v2.FrameworkBody,
 				"\n" + v2.GetTagBody,
 				v2.HeaderBody,
 				"\n" + v2.ListTagsBody,
@@ -135,6 +140,7 @@ func newTemplateBody(version int, kvtValues bool) *TemplateBody {
 			}
 		}
 		return &TemplateBody{
+			"\n" + v2.FrameworkBody,
 			"\n" + v2.GetTagBody,
 			v2.HeaderBody,
 			"\n" + v2.ListTagsBody,
@@ -164,10 +170,13 @@ type TemplateData struct {
 	ListTagsInIDElem        string
 	ListTagsInIDNeedSlice   string
 	ListTagsOp              string
+	ListTagsOpPaginated     bool
 	ListTagsOutTagsElem     string
 	ParentNotFoundErrCode   string
 	ParentNotFoundErrMsg    string
 	RetryCreateOnNotFound   string
+	ServiceTagsMap          bool
+	ServiceTagsType         string
 	SetTagsOutFunc          string
 	TagInCustomVal          string
 	TagInIDElem             string
@@ -192,6 +201,7 @@ type TemplateData struct {
 	UntagInNeedTagType      bool
 	UntagInTagsElem         string
 	UntagOp                 string
+	UpdateTags              bool
 	UpdateTagsFunc          string
 	UpdateTagsIgnoreSystem  bool
 	WaitForPropagation      bool
@@ -206,8 +216,10 @@ type TemplateData struct {
 	// to include the package, set the corresponding field's value to true
 	ConnsPkg         bool
 	FmtPkg           bool
+	FrameworkPkg     bool
 	HelperSchemaPkg  bool
 	InternalTypesPkg bool
+	KVTValues        bool
 	LoggingPkg       bool
 	NamesPkg         bool
 	SkipAWSImp       bool
@@ -219,6 +231,10 @@ type TemplateData struct {
 
 	IsDefaultListTags   bool
 	IsDefaultUpdateTags bool
+
+	// InternalTypesPkgName is the name under which internal/types is imported.
+	// It is aliased when the Terraform Plugin Framework types package is also imported.
+	InternalTypesPkgName string
 }
 
 func main() {
@@ -265,6 +281,32 @@ func main() {
 		g.Fatalf("encountered: %s", err)
 	}
 
+	if *sdkVersion == sdkV1 && (*framework || *listTagsOpPaginated) {
+		g.Fatalf("Framework and ListTagsOpPaginated are only supported with AWS SDK Go v2")
+	}
+
+	if *framework && !*serviceTagsMap && !*serviceTagsSlice {
+		g.Fatalf("Framework requires ServiceTagsMap or ServiceTagsSlice")
+	}
+
+	if *framework && (*tagTypeIDElem != "" || *tagTypeAddBoolElem != "") {
+		g.Fatalf("Framework is not supported with TagTypeIDElem or TagTypeAddBoolElem")
+	}
+
+	if *listTagsOpPaginated && *listTagsInFiltIDName != "" {
+		g.Fatalf("ListTagsOpPaginated is not supported with ListTagsInFiltIDName")
+	}
+
+	var serviceTagsType string
+	switch {
+	case *serviceTagsMap && *kvtValues:
+		serviceTagsType = "map[string]string"
+	case *serviceTagsMap:
+		serviceTagsType = "map[string]*string"
+	default:
+		serviceTagsType = fmt.Sprintf("[]awstypes.%s", *tagType)
+	}
+
 	createTagsFunc := *createTagsFunc
 	if *createTags && !*updateTags {
 		g.Infof("CreateTags only valid with UpdateTags")
@@ -298,8 +340,10 @@ func main() {
 
 		ConnsPkg:         (*listTags && *listTagsFunc == defaultListTagsFunc) || (*updateTags && *updateTagsFunc == defaultUpdateTagsFunc),
 		FmtPkg:           *updateTags,
+		FrameworkPkg:     *framework,
 		HelperSchemaPkg:  awsPkg == "autoscaling",
 		InternalTypesPkg: (*listTags && *listTagsFunc == defaultListTagsFunc) || *serviceTagsMap || *serviceTagsSlice,
+		KVTValues:        *kvtValues,
 		LoggingPkg:       *updateTags,
 		NamesPkg:         *updateTags && !*skipNamesImp,
 		SkipAWSImp:       *skipAWSImp,
@@ -318,9 +362,12 @@ func main() {
 		ListTagsInIDElem:        *listTagsInIDElem,
 		ListTagsInIDNeedSlice:   *listTagsInIDNeedSlice,
 		ListTagsOp:              *listTagsOp,
+		ListTagsOpPaginated:     *listTagsOpPaginated,
 		ListTagsOutTagsElem:     *listTagsOutTagsElem,
 		ParentNotFoundErrCode:   *parentNotFoundErrCode,
 		ParentNotFoundErrMsg:    *parentNotFoundErrMsg,
+		ServiceTagsMap:          *serviceTagsMap,
+		ServiceTagsType:         serviceTagsType,
 		SetTagsOutFunc:          *setTagsOutFunc,
 		TagInCustomVal:          *tagInCustomVal,
 		TagInIDElem:             *tagInIDElem,
@@ -345,6 +392,7 @@ func main() {
 		UntagInNeedTagType:      *untagInNeedTagType,
 		UntagInTagsElem:         *untagInTagsElem,
 		UntagOp:                 *untagOp,
+		UpdateTags:              *updateTags,
 		UpdateTagsFunc:          *updateTagsFunc,
 		UpdateTagsIgnoreSystem:  !*updateTagsNoIgnoreSystem,
 		WaitForPropagation:      *waitForPropagation,
@@ -357,6 +405,12 @@ func main() {
 
 		IsDefaultListTags:   *listTagsFunc == defaultListTagsFunc,
 		IsDefaultUpdateTags: *updateTagsFunc == defaultUpdateTagsFunc,
+
+		InternalTypesPkgName: "types",
+	}
+
+	if *framework {
+		templateData.InternalTypesPkgName = "itypes"
 	}
 
 	templateBody := newTemplateBody(*sdkVersion, *kvtValues)
@@ -405,6 +459,12 @@ func main() {
 		}
 	}
 
+	if *framework {
+		if err := d.WriteTemplate("framework", templateBody.framework, templateData); err != nil {
+			g.Fatalf("generating file (%s): %s", filename, err)
+		}
+	}
+
 	if *waitForPropagation {
 		if err := d.WriteTemplate("waittagspropagated", templateBody.waitTagsPropagated, templateData); err != nil {
 			g.Fatalf("generating file (%s): %s", filename, err)
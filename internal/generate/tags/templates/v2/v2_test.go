@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package v2
+
+import (
+	"bytes"
+	"go/format"
+	"strings"
+	"testing"
+	"text/template"
+)
+
+func executeTemplate(t *testing.T, body string, data map[string]any) string {
+	t.Helper()
+
+	tmpl, err := template.New("test").Funcs(template.FuncMap{
+		"Title": strings.Title,
+	}).Parse(body)
+
+	if err != nil {
+		t.Fatalf("parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, data); err != nil {
+		t.Fatalf("executing template: %s", err)
+	}
+
+	source, err := format.Source(append([]byte("package test\n\n"), buffer.Bytes()...))
+
+	if err != nil {
+		t.Fatalf("formatting generated code: %s\n%s", err, buffer.String())
+	}
+
+	return string(source)
+}
+
+func templateData() map[string]any {
+	return map[string]any{
+		"AWSService":           "testservice",
+		"ClientType":           "*testservice.Client",
+		"InternalTypesPkgName": "types",
+		"KeyValueTagsFunc":     "KeyValueTags",
+		"ListTagsFunc":         "listTags",
+		"ListTagsInIDElem":     "ResourceArn",
+		"ListTagsOp":           "ListTagsForResource",
+		"ListTagsOutTagsElem":  "Tags",
+		"ServicePackage":       "testservice",
+		"ServiceTagsType":      "[]awstypes.Tag",
+		"TagInIDElem":          "ResourceArn",
+		"TagInTagsElem":        "Tags",
+		"TagOp":                "TagResource",
+		"TagPackage":           "testservice",
+		"TagType":              "Tag",
+		"TagsFunc":             "Tags",
+		"UntagInTagsElem":      "TagKeys",
+		"UntagOp":              "UntagResource",
+		"UpdateTagsFunc":       "updateTags",
+	}
+}
+
+func TestUpdateTagsBody_batched(t *testing.T) {
+	t.Parallel()
+
+	data := templateData()
+	data["TagOpBatchSize"] = "20"
+
+	got := executeTemplate(t, UpdateTagsBody, data)
+
+	for _, want := range []string{
+		"for _, removedTags := range removedTags.Chunks(20) {",
+		"for _, updatedTags := range updatedTags.Chunks(20) {",
+		"_, err := conn.UntagResource(ctx, input)",
+		"_, err := conn.TagResource(ctx, input)",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestUpdateTagsBody_unbatched(t *testing.T) {
+	t.Parallel()
+
+	got := executeTemplate(t, UpdateTagsBody, templateData())
+
+	if strings.Contains(got, ".Chunks(") {
+		t.Errorf("generated code unexpectedly batches tag operations:\n%s", got)
+	}
+}
+
+func TestListTagsBody_paginated(t *testing.T) {
+	t.Parallel()
+
+	data := templateData()
+	data["ListTagsOpPaginated"] = true
+
+	got := executeTemplate(t, ListTagsBody, data)
+
+	for _, want := range []string{
+		"var output []awstypes.Tag",
+		"pages := testservice.NewListTagsForResourcePaginator(conn, input)",
+		"for pages.HasMorePages() {",
+		"output = append(output, page.Tags...)",
+		"return KeyValueTags(ctx, output), nil",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestListTagsBody_paginatedMap(t *testing.T) {
+	t.Parallel()
+
+	data := templateData()
+	data["ListTagsOpPaginated"] = true
+	data["ServiceTagsMap"] = true
+	data["KVTValues"] = true
+
+	got := executeTemplate(t, ListTagsBody, data)
+
+	for _, want := range []string{
+		"output := make(map[string]string)",
+		"for k, v := range page.Tags {",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestFrameworkBody(t *testing.T) {
+	t.Parallel()
+
+	data := templateData()
+	data["UpdateTags"] = true
+
+	got := executeTemplate(t, FrameworkBody, data)
+
+	for _, want := range []string{
+		"func TagsFromFramework(ctx context.Context, tags types.Map) []awstypes.Tag {",
+		"func TagsToFramework(ctx context.Context, tags []awstypes.Tag) types.Map {",
+		"func updateTagsFramework(ctx context.Context, conn *testservice.Client, identifier string, oldTags, newTags types.Map) error {",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+}
@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_configured_table", name="Configured Table")
+// @Tags(identifierAttribute="arn")
+func ResourceConfiguredTable() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfiguredTableCreate,
+		ReadWithoutTimeout:   resourceConfiguredTableRead,
+		UpdateWithoutTimeout: resourceConfiguredTableUpdate,
+		DeleteWithoutTimeout: resourceConfiguredTableDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allowed_columns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"analysis_method": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.AnalysisMethod](),
+			},
+			"analysis_rule_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"table_reference": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"table_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameConfiguredTable = "Configured Table"
+)
+
+func resourceConfiguredTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &cleanrooms.CreateConfiguredTableInput{
+		AllowedColumns: flex.ExpandStringValueSet(d.Get("allowed_columns").(*schema.Set)),
+		AnalysisMethod: types.AnalysisMethod(d.Get("analysis_method").(string)),
+		Name:           aws.String(name),
+		TableReference: expandTableReference(d.Get("table_reference").([]interface{})),
+		Tags:           getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	out, err := conn.CreateConfiguredTable(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTable, name, err)
+	}
+
+	if out == nil || out.ConfiguredTable == nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTable, name, errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(out.ConfiguredTable.Id))
+
+	return resourceConfiguredTableRead(ctx, d, meta)
+}
+
+func resourceConfiguredTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	configuredTable, err := findConfiguredTableByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CleanRooms Configured Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionReading, ResNameConfiguredTable, d.Id(), err)
+	}
+
+	d.Set("allowed_columns", configuredTable.AllowedColumns)
+	d.Set("analysis_method", configuredTable.AnalysisMethod)
+	d.Set("analysis_rule_types", enum.Slice(configuredTable.AnalysisRuleTypes...))
+	d.Set(names.AttrARN, configuredTable.Arn)
+	d.Set("create_time", aws.ToTime(configuredTable.CreateTime).Format(time.RFC3339))
+	d.Set(names.AttrDescription, configuredTable.Description)
+	d.Set(names.AttrName, configuredTable.Name)
+	if err := d.Set("table_reference", flattenTableReference(configuredTable.TableReference)); err != nil {
+		return diag.Errorf("setting table_reference: %s", err)
+	}
+	d.Set("update_time", aws.ToTime(configuredTable.UpdateTime).Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceConfiguredTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	if d.HasChanges(names.AttrDescription, names.AttrName) {
+		input := &cleanrooms.UpdateConfiguredTableInput{
+			ConfiguredTableIdentifier: aws.String(d.Id()),
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange(names.AttrName) {
+			input.Name = aws.String(d.Get(names.AttrName).(string))
+		}
+
+		_, err := conn.UpdateConfiguredTable(ctx, input)
+
+		if err != nil {
+			return create.DiagError(names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTable, d.Id(), err)
+		}
+	}
+
+	return resourceConfiguredTableRead(ctx, d, meta)
+}
+
+func resourceConfiguredTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	log.Printf("[INFO] Deleting CleanRooms Configured Table %s", d.Id())
+	_, err := conn.DeleteConfiguredTable(ctx, &cleanrooms.DeleteConfiguredTableInput{
+		ConfiguredTableIdentifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionDeleting, ResNameConfiguredTable, d.Id(), err)
+	}
+
+	return nil
+}
+
+func findConfiguredTableByID(ctx context.Context, conn *cleanrooms.Client, id string) (*types.ConfiguredTable, error) {
+	in := &cleanrooms.GetConfiguredTableInput{
+		ConfiguredTableIdentifier: aws.String(id),
+	}
+
+	out, err := conn.GetConfiguredTable(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.ConfiguredTable == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.ConfiguredTable, nil
+}
+
+func expandTableReference(tfList []interface{}) types.TableReference {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &types.TableReferenceMemberGlue{
+		Value: types.GlueTableReference{
+			DatabaseName: aws.String(tfMap["database_name"].(string)),
+			TableName:    aws.String(tfMap["table_name"].(string)),
+		},
+	}
+}
+
+func flattenTableReference(apiObject types.TableReference) []interface{} {
+	v, ok := apiObject.(*types.TableReferenceMemberGlue)
+
+	if !ok {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"database_name": aws.ToString(v.Value.DatabaseName),
+		"table_name":    aws.ToString(v.Value.TableName),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,558 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_configured_table_analysis_rule", name="Configured Table Analysis Rule")
+func ResourceConfiguredTableAnalysisRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfiguredTableAnalysisRuleCreate,
+		ReadWithoutTimeout:   resourceConfiguredTableAnalysisRuleRead,
+		UpdateWithoutTimeout: resourceConfiguredTableAnalysisRuleUpdate,
+		DeleteWithoutTimeout: resourceConfiguredTableAnalysisRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aggregation": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"aggregation", "custom", "list"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aggregate_column": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column_names": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"function": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.AggregateFunctionName](),
+									},
+								},
+							},
+						},
+						"allowed_join_operators": allowedJoinOperatorsSchema(),
+						"dimension_columns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"join_columns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"join_required": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[types.JoinRequiredOption](),
+						},
+						"output_constraint": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"minimum": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(2),
+									},
+									"type": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.AggregationType](),
+									},
+								},
+							},
+						},
+						"scalar_functions": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[types.ScalarFunctions](),
+							},
+						},
+					},
+				},
+			},
+			"analysis_rule_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.ConfiguredTableAnalysisRuleType](),
+			},
+			"configured_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"aggregation", "custom", "list"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_analyses": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allowed_analysis_providers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"list": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"aggregation", "custom", "list"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_join_operators": allowedJoinOperatorsSchema(),
+						"join_columns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"list_columns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: resourceConfiguredTableAnalysisRuleCustomizeDiff,
+	}
+}
+
+func allowedJoinOperatorsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: enum.Validate[types.JoinOperator](),
+		},
+	}
+}
+
+const (
+	ResNameConfiguredTableAnalysisRule = "Configured Table Analysis Rule"
+)
+
+func resourceConfiguredTableAnalysisRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	configuredTableID := d.Get("configured_table_id").(string)
+	analysisRuleType := types.ConfiguredTableAnalysisRuleType(d.Get("analysis_rule_type").(string))
+	id := ConfiguredTableAnalysisRuleCreateResourceID(configuredTableID, analysisRuleType)
+	input := &cleanrooms.CreateConfiguredTableAnalysisRuleInput{
+		AnalysisRulePolicy:        expandConfiguredTableAnalysisRulePolicy(d, analysisRuleType),
+		AnalysisRuleType:          analysisRuleType,
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	_, err := conn.CreateConfiguredTableAnalysisRule(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAnalysisRule, id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceConfiguredTableAnalysisRuleRead(ctx, d, meta)
+}
+
+func resourceConfiguredTableAnalysisRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	configuredTableID, analysisRuleType, err := ConfiguredTableAnalysisRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	analysisRule, err := findConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, configuredTableID, analysisRuleType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CleanRooms Configured Table Analysis Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionReading, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	d.Set("analysis_rule_type", analysisRule.Type)
+	d.Set("configured_table_id", analysisRule.ConfiguredTableId)
+	d.Set("create_time", aws.ToTime(analysisRule.CreateTime).Format(time.RFC3339))
+	d.Set("update_time", aws.ToTime(analysisRule.UpdateTime).Format(time.RFC3339))
+
+	var aggregation, custom, list []interface{}
+	if v, ok := analysisRule.Policy.(*types.ConfiguredTableAnalysisRulePolicyMemberV1); ok {
+		switch v := v.Value.(type) {
+		case *types.ConfiguredTableAnalysisRulePolicyV1MemberAggregation:
+			aggregation = []interface{}{flattenAnalysisRuleAggregation(&v.Value)}
+		case *types.ConfiguredTableAnalysisRulePolicyV1MemberCustom:
+			custom = []interface{}{flattenAnalysisRuleCustom(&v.Value)}
+		case *types.ConfiguredTableAnalysisRulePolicyV1MemberList:
+			list = []interface{}{flattenAnalysisRuleList(&v.Value)}
+		}
+	}
+	if err := d.Set("aggregation", aggregation); err != nil {
+		return diag.Errorf("setting aggregation: %s", err)
+	}
+	if err := d.Set("custom", custom); err != nil {
+		return diag.Errorf("setting custom: %s", err)
+	}
+	if err := d.Set("list", list); err != nil {
+		return diag.Errorf("setting list: %s", err)
+	}
+
+	return nil
+}
+
+func resourceConfiguredTableAnalysisRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	configuredTableID, analysisRuleType, err := ConfiguredTableAnalysisRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &cleanrooms.UpdateConfiguredTableAnalysisRuleInput{
+		AnalysisRulePolicy:        expandConfiguredTableAnalysisRulePolicy(d, analysisRuleType),
+		AnalysisRuleType:          analysisRuleType,
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	_, err = conn.UpdateConfiguredTableAnalysisRule(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	return resourceConfiguredTableAnalysisRuleRead(ctx, d, meta)
+}
+
+func resourceConfiguredTableAnalysisRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	configuredTableID, analysisRuleType, err := ConfiguredTableAnalysisRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting CleanRooms Configured Table Analysis Rule %s", d.Id())
+	_, err = conn.DeleteConfiguredTableAnalysisRule(ctx, &cleanrooms.DeleteConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          analysisRuleType,
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionDeleting, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceConfiguredTableAnalysisRuleCustomizeDiff ensures that the configured policy block matches the analysis rule type.
+func resourceConfiguredTableAnalysisRuleCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	analysisRuleType := d.Get("analysis_rule_type").(string)
+
+	if analysisRuleType == "" {
+		return nil
+	}
+
+	if block := strings.ToLower(analysisRuleType); len(d.Get(block).([]interface{})) == 0 {
+		return fmt.Errorf("a %q block is required when analysis_rule_type is %s", block, analysisRuleType)
+	}
+
+	return nil
+}
+
+const configuredTableAnalysisRuleResourceIDSeparator = ","
+
+func ConfiguredTableAnalysisRuleCreateResourceID(configuredTableID string, analysisRuleType types.ConfiguredTableAnalysisRuleType) string {
+	parts := []string{configuredTableID, string(analysisRuleType)}
+	id := strings.Join(parts, configuredTableAnalysisRuleResourceIDSeparator)
+
+	return id
+}
+
+func ConfiguredTableAnalysisRuleParseResourceID(id string) (string, types.ConfiguredTableAnalysisRuleType, error) {
+	parts := strings.Split(id, configuredTableAnalysisRuleResourceIDSeparator)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected configured-table-id%[2]sanalysis-rule-type", id, configuredTableAnalysisRuleResourceIDSeparator)
+	}
+
+	return parts[0], types.ConfiguredTableAnalysisRuleType(parts[1]), nil
+}
+
+func findConfiguredTableAnalysisRuleByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, configuredTableID string, analysisRuleType types.ConfiguredTableAnalysisRuleType) (*types.ConfiguredTableAnalysisRule, error) {
+	in := &cleanrooms.GetConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          analysisRuleType,
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	out, err := conn.GetConfiguredTableAnalysisRule(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AnalysisRule == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.AnalysisRule, nil
+}
+
+func expandConfiguredTableAnalysisRulePolicy(d *schema.ResourceData, analysisRuleType types.ConfiguredTableAnalysisRuleType) types.ConfiguredTableAnalysisRulePolicy {
+	var policy types.ConfiguredTableAnalysisRulePolicyV1
+
+	switch analysisRuleType {
+	case types.ConfiguredTableAnalysisRuleTypeAggregation:
+		if v, ok := d.GetOk("aggregation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			policy = &types.ConfiguredTableAnalysisRulePolicyV1MemberAggregation{
+				Value: expandAnalysisRuleAggregation(v.([]interface{})[0].(map[string]interface{})),
+			}
+		}
+	case types.ConfiguredTableAnalysisRuleTypeCustom:
+		if v, ok := d.GetOk("custom"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			policy = &types.ConfiguredTableAnalysisRulePolicyV1MemberCustom{
+				Value: expandAnalysisRuleCustom(v.([]interface{})[0].(map[string]interface{})),
+			}
+		}
+	case types.ConfiguredTableAnalysisRuleTypeList:
+		if v, ok := d.GetOk("list"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			policy = &types.ConfiguredTableAnalysisRulePolicyV1MemberList{
+				Value: expandAnalysisRuleList(v.([]interface{})[0].(map[string]interface{})),
+			}
+		}
+	}
+
+	if policy == nil {
+		return nil
+	}
+
+	return &types.ConfiguredTableAnalysisRulePolicyMemberV1{
+		Value: policy,
+	}
+}
+
+func expandAnalysisRuleAggregation(tfMap map[string]interface{}) types.AnalysisRuleAggregation {
+	apiObject := types.AnalysisRuleAggregation{
+		AggregateColumns:  expandAggregateColumns(tfMap["aggregate_column"].([]interface{})),
+		DimensionColumns:  flex.ExpandStringValueSet(tfMap["dimension_columns"].(*schema.Set)),
+		JoinColumns:       flex.ExpandStringValueSet(tfMap["join_columns"].(*schema.Set)),
+		OutputConstraints: expandAggregationConstraints(tfMap["output_constraint"].([]interface{})),
+		ScalarFunctions:   flex.ExpandStringyValueSet[types.ScalarFunctions](tfMap["scalar_functions"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["allowed_join_operators"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedJoinOperators = flex.ExpandStringyValueSet[types.JoinOperator](v)
+	}
+
+	if v, ok := tfMap["join_required"].(string); ok && v != "" {
+		apiObject.JoinRequired = types.JoinRequiredOption(v)
+	}
+
+	return apiObject
+}
+
+func expandAggregateColumns(tfList []interface{}) []types.AggregateColumn {
+	apiObjects := make([]types.AggregateColumn, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.AggregateColumn{
+			ColumnNames: flex.ExpandStringValueSet(tfMap["column_names"].(*schema.Set)),
+			Function:    types.AggregateFunctionName(tfMap["function"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandAggregationConstraints(tfList []interface{}) []types.AggregationConstraint {
+	apiObjects := make([]types.AggregationConstraint, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.AggregationConstraint{
+			ColumnName: aws.String(tfMap["column_name"].(string)),
+			Minimum:    aws.Int32(int32(tfMap["minimum"].(int))),
+			Type:       types.AggregationType(tfMap["type"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandAnalysisRuleCustom(tfMap map[string]interface{}) types.AnalysisRuleCustom {
+	apiObject := types.AnalysisRuleCustom{
+		AllowedAnalyses: flex.ExpandStringValueSet(tfMap["allowed_analyses"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["allowed_analysis_providers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedAnalysisProviders = flex.ExpandStringValueSet(v)
+	}
+
+	return apiObject
+}
+
+func expandAnalysisRuleList(tfMap map[string]interface{}) types.AnalysisRuleList {
+	apiObject := types.AnalysisRuleList{
+		JoinColumns: flex.ExpandStringValueSet(tfMap["join_columns"].(*schema.Set)),
+		ListColumns: flex.ExpandStringValueSet(tfMap["list_columns"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["allowed_join_operators"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedJoinOperators = flex.ExpandStringyValueSet[types.JoinOperator](v)
+	}
+
+	return apiObject
+}
+
+func flattenAnalysisRuleAggregation(apiObject *types.AnalysisRuleAggregation) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"allowed_join_operators": enum.Slice(apiObject.AllowedJoinOperators...),
+		"dimension_columns":      apiObject.DimensionColumns,
+		"join_columns":           apiObject.JoinColumns,
+		"join_required":          string(apiObject.JoinRequired),
+		"scalar_functions":       enum.Slice(apiObject.ScalarFunctions...),
+	}
+
+	aggregateColumns := make([]interface{}, 0, len(apiObject.AggregateColumns))
+	for _, v := range apiObject.AggregateColumns {
+		aggregateColumns = append(aggregateColumns, map[string]interface{}{
+			"column_names": v.ColumnNames,
+			"function":     string(v.Function),
+		})
+	}
+	tfMap["aggregate_column"] = aggregateColumns
+
+	outputConstraints := make([]interface{}, 0, len(apiObject.OutputConstraints))
+	for _, v := range apiObject.OutputConstraints {
+		outputConstraints = append(outputConstraints, map[string]interface{}{
+			"column_name": aws.ToString(v.ColumnName),
+			"minimum":     int(aws.ToInt32(v.Minimum)),
+			"type":        string(v.Type),
+		})
+	}
+	tfMap["output_constraint"] = outputConstraints
+
+	return tfMap
+}
+
+func flattenAnalysisRuleCustom(apiObject *types.AnalysisRuleCustom) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"allowed_analyses":           apiObject.AllowedAnalyses,
+		"allowed_analysis_providers": apiObject.AllowedAnalysisProviders,
+	}
+
+	return tfMap
+}
+
+func flattenAnalysisRuleList(apiObject *types.AnalysisRuleList) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"allowed_join_operators": enum.Slice(apiObject.AllowedJoinOperators...),
+		"join_columns":           apiObject.JoinColumns,
+		"list_columns":           apiObject.ListColumns,
+	}
+
+	return tfMap
+}
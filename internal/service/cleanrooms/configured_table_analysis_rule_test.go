@@ -0,0 +1,305 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_aggregation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_aggregation(rName, 100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.aggregate_column.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.aggregate_column.0.function", "COUNT"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.dimension_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.join_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.output_constraint.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.output_constraint.0.minimum", "100"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.output_constraint.0.type", "COUNT_DISTINCT"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.scalar_functions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_type", "AGGREGATION"),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_id", "aws_cleanrooms_configured_table.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "custom.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "list.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_aggregation(rName, 200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.output_constraint.0.minimum", "200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_list(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_list(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_type", "LIST"),
+					resource.TestCheckResourceAttr(resourceName, "list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "list.0.allowed_join_operators.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "list.0.join_columns.*", "my_column_1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "list.0.list_columns.*", "my_column_2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_custom(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_custom(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_type", "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "custom.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom.0.allowed_analyses.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "custom.0.allowed_analyses.*", "ANY_QUERY"),
+					resource.TestCheckResourceAttr(resourceName, "custom.0.allowed_analysis_providers.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_list(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceConfiguredTableAnalysisRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_mismatchedType(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConfiguredTableAnalysisRuleConfig_mismatchedType(rName),
+				ExpectError: regexp.MustCompile(`a "aggregation" block is required when analysis_rule_type is AGGREGATION`),
+			},
+		},
+	})
+}
+
+func testAccCheckConfiguredTableAnalysisRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_configured_table_analysis_rule" {
+				continue
+			}
+
+			configuredTableID, analysisRuleType, err := tfcleanrooms.ConfiguredTableAnalysisRuleParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfcleanrooms.FindConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, configuredTableID, analysisRuleType)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CleanRooms Configured Table Analysis Rule %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfiguredTableAnalysisRuleExists(ctx context.Context, n string, v *types.ConfiguredTableAnalysisRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		configuredTableID, analysisRuleType, err := tfcleanrooms.ConfiguredTableAnalysisRuleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		output, err := tfcleanrooms.FindConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, configuredTableID, analysisRuleType)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_aggregation(rName string, minimum int) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_basic(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type  = "AGGREGATION"
+
+  aggregation {
+    aggregate_column {
+      column_names = ["my_column_2"]
+      function     = "COUNT"
+    }
+
+    dimension_columns = ["my_column_2"]
+    join_columns      = ["my_column_1"]
+    scalar_functions  = ["ABS", "CAST"]
+
+    output_constraint {
+      column_name = "my_column_1"
+      minimum     = %[1]d
+      type        = "COUNT_DISTINCT"
+    }
+  }
+}
+`, minimum))
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_list(rName string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_basic(rName), `
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type  = "LIST"
+
+  list {
+    allowed_join_operators = ["AND", "OR"]
+    join_columns           = ["my_column_1"]
+    list_columns           = ["my_column_2"]
+  }
+}
+`)
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_custom(rName string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_basic(rName), `
+data "aws_caller_identity" "current" {}
+
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type  = "CUSTOM"
+
+  custom {
+    allowed_analyses           = ["ANY_QUERY"]
+    allowed_analysis_providers = [data.aws_caller_identity.current.account_id]
+  }
+}
+`)
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_mismatchedType(rName string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_basic(rName), `
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type  = "AGGREGATION"
+
+  list {
+    join_columns = ["my_column_1"]
+    list_columns = ["my_column_2"]
+  }
+}
+`)
+}
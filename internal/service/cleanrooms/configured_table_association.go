@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_configured_table_association", name="Configured Table Association")
+// @Tags(identifierAttribute="arn")
+func ResourceConfiguredTableAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfiguredTableAssociationCreate,
+		ReadWithoutTimeout:   resourceConfiguredTableAssociationRead,
+		UpdateWithoutTimeout: resourceConfiguredTableAssociationUpdate,
+		DeleteWithoutTimeout: resourceConfiguredTableAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configured_table_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configured_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"membership_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"membership_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameConfiguredTableAssociation = "Configured Table Association"
+)
+
+func resourceConfiguredTableAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	membershipID := d.Get("membership_id").(string)
+	name := d.Get(names.AttrName).(string)
+	input := &cleanrooms.CreateConfiguredTableAssociationInput{
+		ConfiguredTableIdentifier: aws.String(d.Get("configured_table_id").(string)),
+		MembershipIdentifier:      aws.String(membershipID),
+		Name:                      aws.String(name),
+		RoleArn:                   aws.String(d.Get("role_arn").(string)),
+		Tags:                      getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	out, err := conn.CreateConfiguredTableAssociation(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAssociation, name, err)
+	}
+
+	if out == nil || out.ConfiguredTableAssociation == nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAssociation, name, errors.New("empty output"))
+	}
+
+	d.SetId(ConfiguredTableAssociationCreateResourceID(membershipID, aws.ToString(out.ConfiguredTableAssociation.Id)))
+
+	return resourceConfiguredTableAssociationRead(ctx, d, meta)
+}
+
+func resourceConfiguredTableAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	membershipID, associationID, err := ConfiguredTableAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	association, err := findConfiguredTableAssociationByTwoPartKey(ctx, conn, membershipID, associationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CleanRooms Configured Table Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionReading, ResNameConfiguredTableAssociation, d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, association.Arn)
+	d.Set("configured_table_arn", association.ConfiguredTableArn)
+	d.Set("configured_table_id", association.ConfiguredTableId)
+	d.Set("create_time", aws.ToTime(association.CreateTime).Format(time.RFC3339))
+	d.Set(names.AttrDescription, association.Description)
+	d.Set("membership_arn", association.MembershipArn)
+	d.Set("membership_id", association.MembershipId)
+	d.Set(names.AttrName, association.Name)
+	d.Set("role_arn", association.RoleArn)
+	d.Set("update_time", aws.ToTime(association.UpdateTime).Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceConfiguredTableAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	if d.HasChanges(names.AttrDescription, "role_arn") {
+		membershipID, associationID, err := ConfiguredTableAssociationParseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &cleanrooms.UpdateConfiguredTableAssociationInput{
+			ConfiguredTableAssociationIdentifier: aws.String(associationID),
+			MembershipIdentifier:                 aws.String(membershipID),
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		_, err = conn.UpdateConfiguredTableAssociation(ctx, input)
+
+		if err != nil {
+			return create.DiagError(names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTableAssociation, d.Id(), err)
+		}
+	}
+
+	return resourceConfiguredTableAssociationRead(ctx, d, meta)
+}
+
+func resourceConfiguredTableAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	membershipID, associationID, err := ConfiguredTableAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting CleanRooms Configured Table Association %s", d.Id())
+	_, err = conn.DeleteConfiguredTableAssociation(ctx, &cleanrooms.DeleteConfiguredTableAssociationInput{
+		ConfiguredTableAssociationIdentifier: aws.String(associationID),
+		MembershipIdentifier:                 aws.String(membershipID),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionDeleting, ResNameConfiguredTableAssociation, d.Id(), err)
+	}
+
+	return nil
+}
+
+const configuredTableAssociationResourceIDSeparator = ","
+
+func ConfiguredTableAssociationCreateResourceID(membershipID, associationID string) string {
+	parts := []string{membershipID, associationID}
+	id := strings.Join(parts, configuredTableAssociationResourceIDSeparator)
+
+	return id
+}
+
+func ConfiguredTableAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, configuredTableAssociationResourceIDSeparator)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected membership-id%[2]sconfigured-table-association-id", id, configuredTableAssociationResourceIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func findConfiguredTableAssociationByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, associationID string) (*types.ConfiguredTableAssociation, error) {
+	in := &cleanrooms.GetConfiguredTableAssociationInput{
+		ConfiguredTableAssociationIdentifier: aws.String(associationID),
+		MembershipIdentifier:                 aws.String(membershipID),
+	}
+
+	out, err := conn.GetConfiguredTableAssociation(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.ConfiguredTableAssociation == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.ConfiguredTableAssociation, nil
+}
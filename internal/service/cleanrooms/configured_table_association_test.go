@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsConfiguredTableAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.ConfiguredTableAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAssociationConfig_basic(rName, "description1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfiguredTableAssociationExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "cleanrooms", regexp.MustCompile(`membership/.+/configuredtableassociation/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_arn", "aws_cleanrooms_configured_table.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_id", "aws_cleanrooms_configured_table.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttrPair(resourceName, "membership_arn", "aws_cleanrooms_membership.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "membership_id", "aws_cleanrooms_membership.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "test_table"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfiguredTableAssociationConfig_basic(rName, "description2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfiguredTableAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.ConfiguredTableAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAssociationConfig_basic(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAssociationExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceConfiguredTableAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfiguredTableAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_configured_table_association" {
+				continue
+			}
+
+			membershipID, associationID, err := tfcleanrooms.ConfiguredTableAssociationParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfcleanrooms.FindConfiguredTableAssociationByTwoPartKey(ctx, conn, membershipID, associationID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CleanRooms Configured Table Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfiguredTableAssociationExists(ctx context.Context, n string, v *types.ConfiguredTableAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		membershipID, associationID, err := tfcleanrooms.ConfiguredTableAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		output, err := tfcleanrooms.FindConfiguredTableAssociationByTwoPartKey(ctx, conn, membershipID, associationID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccConfiguredTableAssociationConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(
+		testAccConfiguredTableConfig_basic(rName),
+		testAccMembershipConfig_basic(rName, "DISABLED"),
+		fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "cleanrooms.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "glue:GetDatabase",
+        "glue:GetDatabases",
+        "glue:GetTable",
+        "glue:GetTables",
+        "glue:GetPartition",
+        "glue:GetPartitions",
+        "glue:BatchGetPartition",
+      ]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_cleanrooms_configured_table_association" "test" {
+  name                = "test_table"
+  description         = %[2]q
+  membership_id       = aws_cleanrooms_membership.test.id
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  role_arn            = aws_iam_role.test.arn
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, description))
+}
@@ -0,0 +1,320 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsConfiguredTable_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.ConfiguredTable
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfiguredTableExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "allowed_columns.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_columns.*", "my_column_1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_columns.*", "my_column_2"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_method", "DIRECT_QUERY"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_types.#", "0"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "cleanrooms", regexp.MustCompile(`configuredtable/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "table_reference.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "table_reference.0.database_name", "aws_glue_catalog_database.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "table_reference.0.table_name", "aws_glue_catalog_table.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTable_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.ConfiguredTable
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceConfiguredTable(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTable_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.ConfiguredTable
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableConfig_description(rName, rName, "description1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfiguredTableExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfiguredTableConfig_description(rName, rNameUpdated, "description2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfiguredTableExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTable_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.ConfiguredTable
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfiguredTableConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccConfiguredTableConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConfiguredTableDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_configured_table" {
+				continue
+			}
+
+			_, err := tfcleanrooms.FindConfiguredTableByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CleanRooms Configured Table %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfiguredTableExists(ctx context.Context, n string, v *types.ConfiguredTable) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		output, err := tfcleanrooms.FindConfiguredTableByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccConfiguredTableConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = replace(%[1]q, "-", "_")
+}
+
+resource "aws_glue_catalog_table" "test" {
+  database_name = aws_glue_catalog_database.test.name
+  name          = replace(%[1]q, "-", "_")
+  table_type    = "EXTERNAL_TABLE"
+
+  storage_descriptor {
+    location      = "s3://example-bucket/data/"
+    input_format  = "org.apache.hadoop.mapred.TextInputFormat"
+    output_format = "org.apache.hadoop.hive.ql.io.HiveIgnoreKeyTextOutputFormat"
+
+    ser_de_info {
+      serialization_library = "org.apache.hadoop.hive.serde2.lazy.LazySimpleSerDe"
+    }
+
+    columns {
+      name = "my_column_1"
+      type = "string"
+    }
+
+    columns {
+      name = "my_column_2"
+      type = "bigint"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccConfiguredTableConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_base(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table" "test" {
+  name            = %[1]q
+  analysis_method = "DIRECT_QUERY"
+  allowed_columns = ["my_column_1", "my_column_2"]
+
+  table_reference {
+    database_name = aws_glue_catalog_database.test.name
+    table_name    = aws_glue_catalog_table.test.name
+  }
+}
+`, rName))
+}
+
+func testAccConfiguredTableConfig_description(rName, name, description string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_base(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table" "test" {
+  name            = %[1]q
+  description     = %[2]q
+  analysis_method = "DIRECT_QUERY"
+  allowed_columns = ["my_column_1", "my_column_2"]
+
+  table_reference {
+    database_name = aws_glue_catalog_database.test.name
+    table_name    = aws_glue_catalog_table.test.name
+  }
+}
+`, name, description))
+}
+
+func testAccConfiguredTableConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_base(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table" "test" {
+  name            = %[1]q
+  analysis_method = "DIRECT_QUERY"
+  allowed_columns = ["my_column_1", "my_column_2"]
+
+  table_reference {
+    database_name = aws_glue_catalog_database.test.name
+    table_name    = aws_glue_catalog_table.test.name
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccConfiguredTableConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_base(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table" "test" {
+  name            = %[1]q
+  analysis_method = "DIRECT_QUERY"
+  allowed_columns = ["my_column_1", "my_column_2"]
+
+  table_reference {
+    database_name = aws_glue_catalog_database.test.name
+    table_name    = aws_glue_catalog_table.test.name
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,12 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+// Exports for use in tests only.
+var (
+	FindConfiguredTableAnalysisRuleByTwoPartKey = findConfiguredTableAnalysisRuleByTwoPartKey
+	FindConfiguredTableAssociationByTwoPartKey  = findConfiguredTableAssociationByTwoPartKey
+	FindConfiguredTableByID                     = findConfiguredTableByID
+	FindMembershipByID                          = findMembershipByID
+)
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_membership", name="Membership")
+// @Tags(identifierAttribute="arn")
+func ResourceMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMembershipCreate,
+		ReadWithoutTimeout:   resourceMembershipRead,
+		UpdateWithoutTimeout: resourceMembershipUpdate,
+		DeleteWithoutTimeout: resourceMembershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_creator_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_creator_display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"collaboration_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"member_abilities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"query_log_status": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.MembershipQueryLogStatus](),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameMembership = "Membership"
+)
+
+func resourceMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	collaborationID := d.Get("collaboration_id").(string)
+	input := &cleanrooms.CreateMembershipInput{
+		CollaborationIdentifier: aws.String(collaborationID),
+		QueryLogStatus:          types.MembershipQueryLogStatus(d.Get("query_log_status").(string)),
+		Tags:                    getTagsIn(ctx),
+	}
+
+	out, err := conn.CreateMembership(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionCreating, ResNameMembership, collaborationID, err)
+	}
+
+	if out == nil || out.Membership == nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionCreating, ResNameMembership, collaborationID, errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(out.Membership.Id))
+
+	return resourceMembershipRead(ctx, d, meta)
+}
+
+func resourceMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	membership, err := findMembershipByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CleanRooms Membership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionReading, ResNameMembership, d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, membership.Arn)
+	d.Set("collaboration_arn", membership.CollaborationArn)
+	d.Set("collaboration_creator_account_id", membership.CollaborationCreatorAccountId)
+	d.Set("collaboration_creator_display_name", membership.CollaborationCreatorDisplayName)
+	d.Set("collaboration_id", membership.CollaborationId)
+	d.Set("collaboration_name", membership.CollaborationName)
+	d.Set("create_time", aws.ToTime(membership.CreateTime).Format(time.RFC3339))
+	d.Set("member_abilities", flattenMemberAbilities(membership.MemberAbilities))
+	d.Set("query_log_status", membership.QueryLogStatus)
+	d.Set("status", membership.Status)
+	d.Set("update_time", aws.ToTime(membership.UpdateTime).Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	if d.HasChange("query_log_status") {
+		input := &cleanrooms.UpdateMembershipInput{
+			MembershipIdentifier: aws.String(d.Id()),
+			QueryLogStatus:       types.MembershipQueryLogStatus(d.Get("query_log_status").(string)),
+		}
+
+		_, err := conn.UpdateMembership(ctx, input)
+
+		if err != nil {
+			return create.DiagError(names.CleanRooms, create.ErrActionUpdating, ResNameMembership, d.Id(), err)
+		}
+	}
+
+	return resourceMembershipRead(ctx, d, meta)
+}
+
+func resourceMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	log.Printf("[INFO] Deleting CleanRooms Membership %s", d.Id())
+	_, err := conn.DeleteMembership(ctx, &cleanrooms.DeleteMembershipInput{
+		MembershipIdentifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionDeleting, ResNameMembership, d.Id(), err)
+	}
+
+	return nil
+}
+
+func findMembershipByID(ctx context.Context, conn *cleanrooms.Client, id string) (*types.Membership, error) {
+	in := &cleanrooms.GetMembershipInput{
+		MembershipIdentifier: aws.String(id),
+	}
+
+	out, err := conn.GetMembership(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Membership == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	if status := out.Membership.Status; status == types.MembershipStatusRemoved || status == types.MembershipStatusCollaborationDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: in,
+		}
+	}
+
+	return out.Membership, nil
+}
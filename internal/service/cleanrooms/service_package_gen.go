@@ -35,6 +35,35 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceConfiguredTable,
+			TypeName: "aws_cleanrooms_configured_table",
+			Name:     "Configured Table",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceConfiguredTableAnalysisRule,
+			TypeName: "aws_cleanrooms_configured_table_analysis_rule",
+			Name:     "Configured Table Analysis Rule",
+		},
+		{
+			Factory:  ResourceConfiguredTableAssociation,
+			TypeName: "aws_cleanrooms_configured_table_association",
+			Name:     "Configured Table Association",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceMembership,
+			TypeName: "aws_cleanrooms_membership",
+			Name:     "Membership",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 	}
 }
 
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// Enrollment and recommendation preferences are account-wide, so their tests must not run in parallel.
+func TestAccComputeOptimizer_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"EnrollmentStatus": {
+			"basic":                 testAccEnrollmentStatus_basic,
+			"includeMemberAccounts": testAccEnrollmentStatus_includeMemberAccounts,
+		},
+		"RecommendationPreferences": {
+			"basic":      testAccRecommendationPreferences_basic,
+			"disappears": testAccRecommendationPreferences_disappears,
+			"update":     testAccRecommendationPreferences_update,
+		},
+		"RecommendationsDataSource": {
+			"ec2Instance": testAccRecommendationsDataSource_ec2Instance,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
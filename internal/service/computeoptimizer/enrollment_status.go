@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Enrollment Status")
+func newResourceEnrollmentStatus(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceEnrollmentStatus{}
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+	r.SetDefaultDeleteTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type resourceEnrollmentStatus struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceEnrollmentStatus) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_computeoptimizer_enrollment_status"
+}
+
+func (r *resourceEnrollmentStatus) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"include_member_accounts": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"number_of_member_accounts_opted_in": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Slice(awstypes.StatusActive, awstypes.StatusInactive)...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceEnrollmentStatus) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceEnrollmentStatusData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	data.ID = types.StringValue(r.Meta().AccountID)
+
+	output, err := updateEnrollmentStatus(ctx, conn, &data, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Compute Optimizer Enrollment Status (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.NumberOfMemberAccountsOptedIn = flex.Int64ToFramework(ctx, flattenInt32(output.NumberOfMemberAccountsOptedIn))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceEnrollmentStatus) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceEnrollmentStatusData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	output, err := findEnrollmentStatus(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Compute Optimizer Enrollment Status (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.IncludeMemberAccounts = types.BoolValue(output.MemberAccountsEnrolled)
+	data.NumberOfMemberAccountsOptedIn = flex.Int64ToFramework(ctx, flattenInt32(output.NumberOfMemberAccountsOptedIn))
+	data.Status = flex.StringValueToFramework(ctx, output.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceEnrollmentStatus) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceEnrollmentStatusData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	if !new.IncludeMemberAccounts.Equal(old.IncludeMemberAccounts) || !new.Status.Equal(old.Status) {
+		output, err := updateEnrollmentStatus(ctx, conn, &new, r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Compute Optimizer Enrollment Status (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.NumberOfMemberAccountsOptedIn = flex.Int64ToFramework(ctx, flattenInt32(output.NumberOfMemberAccountsOptedIn))
+	} else {
+		new.NumberOfMemberAccountsOptedIn = old.NumberOfMemberAccountsOptedIn
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// Delete opts the account out of Compute Optimizer.
+func (r *resourceEnrollmentStatus) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceEnrollmentStatusData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	data.Status = flex.StringValueToFramework(ctx, awstypes.StatusInactive)
+
+	if _, err := updateEnrollmentStatus(ctx, conn, &data, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Compute Optimizer Enrollment Status (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type resourceEnrollmentStatusData struct {
+	ID                            types.String   `tfsdk:"id"`
+	IncludeMemberAccounts         types.Bool     `tfsdk:"include_member_accounts"`
+	NumberOfMemberAccountsOptedIn types.Int64    `tfsdk:"number_of_member_accounts_opted_in"`
+	Status                        types.String   `tfsdk:"status"`
+	Timeouts                      timeouts.Value `tfsdk:"timeouts"`
+}
+
+func updateEnrollmentStatus(ctx context.Context, conn *computeoptimizer.Client, data *resourceEnrollmentStatusData, timeout time.Duration) (*computeoptimizer.GetEnrollmentStatusOutput, error) {
+	status := awstypes.Status(data.Status.ValueString())
+	input := &computeoptimizer.UpdateEnrollmentStatusInput{
+		IncludeMemberAccounts: data.IncludeMemberAccounts.ValueBool(),
+		Status:                status,
+	}
+
+	if _, err := conn.UpdateEnrollmentStatus(ctx, input); err != nil {
+		return nil, err
+	}
+
+	output, err := waitEnrollmentStatusUpdated(ctx, conn, status, timeout)
+
+	if err != nil {
+		return nil, fmt.Errorf("waiting for update: %w", err)
+	}
+
+	return output, nil
+}
+
+func findEnrollmentStatus(ctx context.Context, conn *computeoptimizer.Client) (*computeoptimizer.GetEnrollmentStatusOutput, error) {
+	input := &computeoptimizer.GetEnrollmentStatusInput{}
+
+	output, err := conn.GetEnrollmentStatus(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusEnrollmentStatus(ctx context.Context, conn *computeoptimizer.Client) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findEnrollmentStatus(ctx, conn)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitEnrollmentStatusUpdated(ctx context.Context, conn *computeoptimizer.Client, target awstypes.Status, timeout time.Duration) (*computeoptimizer.GetEnrollmentStatusOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusPending),
+		Target:  enum.Slice(target),
+		Refresh: statusEnrollmentStatus(ctx, conn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := tfresource.WaitForStatus(ctx, stateConf, tfresource.WithStatusReasonFunc(enrollmentStatusReason))
+
+	if output, ok := outputRaw.(*computeoptimizer.GetEnrollmentStatusOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func enrollmentStatusReason(outputRaw interface{}) string {
+	if output, ok := outputRaw.(*computeoptimizer.GetEnrollmentStatusOutput); ok {
+		return aws.ToString(output.StatusReason)
+	}
+
+	return ""
+}
+
+func flattenInt32(v *int32) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	return aws.Int64(int64(aws.ToInt32(v)))
+}
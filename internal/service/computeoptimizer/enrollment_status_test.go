@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcomputeoptimizer "github.com/hashicorp/terraform-provider-aws/internal/service/computeoptimizer"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccEnrollmentStatus_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_enrollment_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizer),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnrollmentStatusDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnrollmentStatusConfig_basic("Active"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnrollmentStatus(ctx, "Active"),
+					acctest.CheckResourceAttrAccountID(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "include_member_accounts", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Active"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEnrollmentStatusConfig_basic("Inactive"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnrollmentStatus(ctx, "Inactive"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Inactive"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnrollmentStatus_includeMemberAccounts(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_enrollment_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizer),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnrollmentStatusDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnrollmentStatusConfig_includeMemberAccounts(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnrollmentStatus(ctx, "Active"),
+					resource.TestCheckResourceAttr(resourceName, "include_member_accounts", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "number_of_member_accounts_opted_in"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEnrollmentStatusConfig_includeMemberAccounts(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnrollmentStatus(ctx, "Active"),
+					resource.TestCheckResourceAttr(resourceName, "include_member_accounts", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEnrollmentStatus(ctx context.Context, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+		output, err := tfcomputeoptimizer.FindEnrollmentStatus(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		if got := string(output.Status); got != want {
+			return fmt.Errorf("Compute Optimizer Enrollment Status is %s, want %s", got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEnrollmentStatusDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_computeoptimizer_enrollment_status" {
+				continue
+			}
+
+			return testAccCheckEnrollmentStatus(ctx, "Inactive")(s)
+		}
+
+		return nil
+	}
+}
+
+func testAccEnrollmentStatusConfig_basic(status string) string {
+	return fmt.Sprintf(`
+resource "aws_computeoptimizer_enrollment_status" "test" {
+  status = %[1]q
+}
+`, status)
+}
+
+func testAccEnrollmentStatusConfig_includeMemberAccounts(includeMemberAccounts bool) string {
+	return fmt.Sprintf(`
+resource "aws_computeoptimizer_enrollment_status" "test" {
+  status                  = "Active"
+  include_member_accounts = %[1]t
+}
+`, includeMemberAccounts)
+}
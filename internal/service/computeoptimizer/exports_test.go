@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer
+
+// Exports for use in tests only.
+var (
+	ResourceEnrollmentStatus          = newResourceEnrollmentStatus
+	ResourceRecommendationPreferences = newResourceRecommendationPreferences
+
+	FindEnrollmentStatus              = findEnrollmentStatus
+	FindRecommendationPreferencesByID = findRecommendationPreferencesByID
+)
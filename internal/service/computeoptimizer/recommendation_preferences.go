@@ -0,0 +1,458 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Recommendation Preferences")
+func newResourceRecommendationPreferences(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceRecommendationPreferences{}, nil
+}
+
+type resourceRecommendationPreferences struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceRecommendationPreferences) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_computeoptimizer_recommendation_preferences"
+}
+
+func (r *resourceRecommendationPreferences) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enhanced_infrastructure_metrics": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.EnhancedInfrastructureMetrics](),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"inferred_workload_types": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.InferredWorkloadTypesPreference](),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.ResourceType](),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"external_metrics_preference": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[externalMetricsPreferenceData](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								enum.FrameworkValidate[awstypes.ExternalMetricsSource](),
+							},
+						},
+					},
+				},
+			},
+			"scope": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[scopeData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								enum.FrameworkValidate[awstypes.ScopeName](),
+							},
+						},
+						"value": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceRecommendationPreferences) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceRecommendationPreferencesData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	input := &computeoptimizer.PutRecommendationPreferencesInput{}
+
+	response.Diagnostics.Append(flex.Expand(ctx, &data, input)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutRecommendationPreferences(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Compute Optimizer Recommendation Preferences (%s)", data.ResourceType.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.setID(ctx)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findRecommendationPreferencesByThreePartKey(ctx, conn, input.ResourceType, input.Scope)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Compute Optimizer Recommendation Preferences (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.EnhancedInfrastructureMetrics = flex.StringValueToFramework(ctx, output.EnhancedInfrastructureMetrics)
+	data.InferredWorkloadTypes = flex.StringValueToFramework(ctx, output.InferredWorkloadTypes)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceRecommendationPreferences) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceRecommendationPreferencesData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(ctx); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	scope, diags := expandScope(ctx, data.Scope)
+
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findRecommendationPreferencesByThreePartKey(ctx, conn, awstypes.ResourceType(data.ResourceType.ValueString()), scope)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Compute Optimizer Recommendation Preferences (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, output, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceRecommendationPreferences) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceRecommendationPreferencesData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	input := &computeoptimizer.PutRecommendationPreferencesInput{}
+
+	response.Diagnostics.Append(flex.Expand(ctx, &new, input)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutRecommendationPreferences(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Compute Optimizer Recommendation Preferences (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Omitting the external metrics preference from the request leaves the existing preference in place.
+	if !old.ExternalMetricsPreference.IsNull() && new.ExternalMetricsPreference.IsNull() {
+		_, err := conn.DeleteRecommendationPreferences(ctx, &computeoptimizer.DeleteRecommendationPreferencesInput{
+			RecommendationPreferenceNames: []awstypes.RecommendationPreferenceName{awstypes.RecommendationPreferenceNameExternalMetricsPreference},
+			ResourceType:                  input.ResourceType,
+			Scope:                         input.Scope,
+		})
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Compute Optimizer Recommendation Preferences (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	output, err := findRecommendationPreferencesByThreePartKey(ctx, conn, input.ResourceType, input.Scope)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Compute Optimizer Recommendation Preferences (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.EnhancedInfrastructureMetrics = flex.StringValueToFramework(ctx, output.EnhancedInfrastructureMetrics)
+	new.InferredWorkloadTypes = flex.StringValueToFramework(ctx, output.InferredWorkloadTypes)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceRecommendationPreferences) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceRecommendationPreferencesData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	scope, diags := expandScope(ctx, data.Scope)
+
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &computeoptimizer.DeleteRecommendationPreferencesInput{
+		RecommendationPreferenceNames: []awstypes.RecommendationPreferenceName{
+			awstypes.RecommendationPreferenceNameEnhancedInfrastructureMetrics,
+		},
+		ResourceType: awstypes.ResourceType(data.ResourceType.ValueString()),
+		Scope:        scope,
+	}
+
+	if !data.ExternalMetricsPreference.IsNull() {
+		input.RecommendationPreferenceNames = append(input.RecommendationPreferenceNames, awstypes.RecommendationPreferenceNameExternalMetricsPreference)
+	}
+
+	// Inferred workload types are only returned for resource types that support them.
+	if v := data.InferredWorkloadTypes.ValueString(); v != "" {
+		input.RecommendationPreferenceNames = append(input.RecommendationPreferenceNames, awstypes.RecommendationPreferenceNameInferredWorkloadTypes)
+	}
+
+	_, err := conn.DeleteRecommendationPreferences(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Compute Optimizer Recommendation Preferences (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type resourceRecommendationPreferencesData struct {
+	EnhancedInfrastructureMetrics types.String                                                   `tfsdk:"enhanced_infrastructure_metrics"`
+	ExternalMetricsPreference     fwtypes.ListNestedObjectValueOf[externalMetricsPreferenceData] `tfsdk:"external_metrics_preference"`
+	ID                            types.String                                                   `tfsdk:"id"`
+	InferredWorkloadTypes         types.String                                                   `tfsdk:"inferred_workload_types"`
+	ResourceType                  types.String                                                   `tfsdk:"resource_type"`
+	Scope                         fwtypes.ListNestedObjectValueOf[scopeData]                     `tfsdk:"scope"`
+}
+
+type externalMetricsPreferenceData struct {
+	Source types.String `tfsdk:"source"`
+}
+
+type scopeData struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+const (
+	recommendationPreferencesResourceIDSeparator = ","
+)
+
+func (data *resourceRecommendationPreferencesData) InitFromID(ctx context.Context) error {
+	resourceType, scopeName, scopeValue, err := recommendationPreferencesParseResourceID(data.ID.ValueString())
+
+	if err != nil {
+		return err
+	}
+
+	data.ResourceType = types.StringValue(resourceType)
+	data.Scope = fwtypes.NewListNestedObjectValueOfPtr(ctx, &scopeData{
+		Name:  types.StringValue(scopeName),
+		Value: types.StringValue(scopeValue),
+	})
+
+	return nil
+}
+
+func recommendationPreferencesParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, recommendationPreferencesResourceIDSeparator)
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected resource-type%[2]sscope-name%[2]sscope-value", id, recommendationPreferencesResourceIDSeparator)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+func (data *resourceRecommendationPreferencesData) setID(ctx context.Context) diag.Diagnostics {
+	scope, diags := expandScope(ctx, data.Scope)
+
+	if diags.HasError() || scope == nil {
+		return diags
+	}
+
+	data.ID = types.StringValue(strings.Join([]string{data.ResourceType.ValueString(), string(scope.Name), aws.ToString(scope.Value)}, recommendationPreferencesResourceIDSeparator))
+
+	return diags
+}
+
+func expandScope(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[scopeData]) (*awstypes.Scope, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ptr, d := tfList.ToObjectPtr(ctx)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tfObject, ok := ptr.(*scopeData)
+
+	if !ok || tfObject == nil {
+		return nil, diags
+	}
+
+	return &awstypes.Scope{
+		Name:  awstypes.ScopeName(tfObject.Name.ValueString()),
+		Value: flex.StringFromFramework(ctx, tfObject.Value),
+	}, diags
+}
+
+func findRecommendationPreferencesByID(ctx context.Context, conn *computeoptimizer.Client, id string) (*awstypes.RecommendationPreferencesDetail, error) {
+	resourceType, scopeName, scopeValue, err := recommendationPreferencesParseResourceID(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return findRecommendationPreferencesByThreePartKey(ctx, conn, awstypes.ResourceType(resourceType), &awstypes.Scope{
+		Name:  awstypes.ScopeName(scopeName),
+		Value: aws.String(scopeValue),
+	})
+}
+
+func findRecommendationPreferencesByThreePartKey(ctx context.Context, conn *computeoptimizer.Client, resourceType awstypes.ResourceType, scope *awstypes.Scope) (*awstypes.RecommendationPreferencesDetail, error) {
+	input := &computeoptimizer.GetRecommendationPreferencesInput{
+		ResourceType: resourceType,
+		Scope:        scope,
+	}
+
+	pages := computeoptimizer.NewGetRecommendationPreferencesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.RecommendationPreferencesDetails {
+			if v.Scope != nil && v.Scope.Name == scope.Name && aws.ToString(v.Scope.Value) == aws.ToString(scope.Value) {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcomputeoptimizer "github.com/hashicorp/terraform-provider-aws/internal/service/computeoptimizer"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccRecommendationPreferences_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RecommendationPreferencesDetail
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizer),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_basic("Active"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_infrastructure_metrics", "Active"),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "Ec2Instance"),
+					resource.TestCheckResourceAttr(resourceName, "scope.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.name", "AccountId"),
+					acctest.CheckResourceAttrAccountID(resourceName, "scope.0.value"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRecommendationPreferences_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RecommendationPreferencesDetail
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizer),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_basic("Active"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcomputeoptimizer.ResourceRecommendationPreferences, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRecommendationPreferences_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RecommendationPreferencesDetail
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizer),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_externalMetrics("Active", "Datadog"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_infrastructure_metrics", "Active"),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.0.source", "Datadog"),
+					resource.TestCheckResourceAttr(resourceName, "inferred_workload_types", "Active"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRecommendationPreferencesConfig_externalMetrics("Inactive", "Dynatrace"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_infrastructure_metrics", "Inactive"),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.0.source", "Dynatrace"),
+				),
+			},
+			{
+				Config: testAccRecommendationPreferencesConfig_basic("Inactive"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRecommendationPreferencesExists(ctx context.Context, n string, v *awstypes.RecommendationPreferencesDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+		output, err := tfcomputeoptimizer.FindRecommendationPreferencesByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckRecommendationPreferencesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_computeoptimizer_recommendation_preferences" {
+				continue
+			}
+
+			output, err := tfcomputeoptimizer.FindRecommendationPreferencesByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			// Deleted preferences revert to their defaults.
+			if output.EnhancedInfrastructureMetrics == awstypes.EnhancedInfrastructureMetricsInactive && output.ExternalMetricsPreference == nil {
+				continue
+			}
+
+			return fmt.Errorf("Compute Optimizer Recommendation Preferences %s still exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccRecommendationPreferencesConfig_base() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+resource "aws_computeoptimizer_enrollment_status" "test" {
+  status = "Active"
+}
+`
+}
+
+func testAccRecommendationPreferencesConfig_basic(enhancedInfrastructureMetrics string) string {
+	return acctest.ConfigCompose(testAccRecommendationPreferencesConfig_base(), fmt.Sprintf(`
+resource "aws_computeoptimizer_recommendation_preferences" "test" {
+  resource_type                   = "Ec2Instance"
+  enhanced_infrastructure_metrics = %[1]q
+
+  scope {
+    name  = "AccountId"
+    value = data.aws_caller_identity.current.account_id
+  }
+
+  depends_on = [aws_computeoptimizer_enrollment_status.test]
+}
+`, enhancedInfrastructureMetrics))
+}
+
+func testAccRecommendationPreferencesConfig_externalMetrics(enhancedInfrastructureMetrics, source string) string {
+	return acctest.ConfigCompose(testAccRecommendationPreferencesConfig_base(), fmt.Sprintf(`
+resource "aws_computeoptimizer_recommendation_preferences" "test" {
+  resource_type                   = "Ec2Instance"
+  enhanced_infrastructure_metrics = %[1]q
+  inferred_workload_types         = "Active"
+
+  external_metrics_preference {
+    source = %[2]q
+  }
+
+  scope {
+    name  = "AccountId"
+    value = data.aws_caller_identity.current.account_id
+  }
+
+  depends_on = [aws_computeoptimizer_enrollment_status.test]
+}
+`, enhancedInfrastructureMetrics, source))
+}
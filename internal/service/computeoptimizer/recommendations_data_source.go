@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Recommendations")
+func newDataSourceRecommendations(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceRecommendations{}, nil
+}
+
+type dataSourceRecommendations struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceRecommendations) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_computeoptimizer_recommendations"
+}
+
+func (d *dataSourceRecommendations) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"account_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"recommendations": schema.ListNestedAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[recommendationData](ctx),
+				Computed:   true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"account_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"current_instance_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"current_performance_risk": schema.StringAttribute{
+							Computed: true,
+						},
+						"finding": schema.StringAttribute{
+							Computed: true,
+						},
+						"last_refresh_timestamp": schema.StringAttribute{
+							Computed: true,
+						},
+						"look_back_period_in_days": schema.Float64Attribute{
+							Computed: true,
+						},
+						"recommendation_options": schema.ListNestedAttribute{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[recommendationOptionData](ctx),
+							Computed:   true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"estimated_monthly_savings_currency": schema.StringAttribute{
+										Computed: true,
+									},
+									"estimated_monthly_savings_value": schema.Float64Attribute{
+										Computed: true,
+									},
+									"instance_type": schema.StringAttribute{
+										Computed: true,
+									},
+									"migration_effort": schema.StringAttribute{
+										Computed: true,
+									},
+									"performance_risk": schema.Float64Attribute{
+										Computed: true,
+									},
+									"rank": schema.Int64Attribute{
+										Computed: true,
+									},
+									"savings_opportunity_percentage": schema.Float64Attribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+						"resource_arn": schema.StringAttribute{
+							Computed: true,
+						},
+						"resource_name": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"resource_arns": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"resource_type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Slice(awstypes.ResourceTypeEc2Instance, awstypes.ResourceTypeAutoScalingGroup)...),
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceRecommendations) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceRecommendationsData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ComputeOptimizerClient(ctx)
+
+	accountIDs := flex.ExpandFrameworkStringValueSet(ctx, data.AccountIDs)
+	resourceARNs := flex.ExpandFrameworkStringValueSet(ctx, data.ResourceARNs)
+	resourceType := awstypes.ResourceType(data.ResourceType.ValueString())
+
+	var recommendations []*recommendationData
+	var err error
+
+	switch resourceType {
+	case awstypes.ResourceTypeAutoScalingGroup:
+		recommendations, err = findAutoScalingGroupRecommendations(ctx, conn, &computeoptimizer.GetAutoScalingGroupRecommendationsInput{
+			AccountIds:           accountIDs,
+			AutoScalingGroupArns: resourceARNs,
+		})
+	default:
+		recommendations, err = findEC2InstanceRecommendations(ctx, conn, &computeoptimizer.GetEC2InstanceRecommendationsInput{
+			AccountIds:   accountIDs,
+			InstanceArns: resourceARNs,
+		})
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Compute Optimizer %s Recommendations", resourceType), err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(string(resourceType))
+	data.Recommendations = fwtypes.NewListNestedObjectValueOfSlice(ctx, recommendations)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceRecommendationsData struct {
+	AccountIDs      types.Set                                           `tfsdk:"account_ids"`
+	ID              types.String                                        `tfsdk:"id"`
+	Recommendations fwtypes.ListNestedObjectValueOf[recommendationData] `tfsdk:"recommendations"`
+	ResourceARNs    types.Set                                           `tfsdk:"resource_arns"`
+	ResourceType    types.String                                        `tfsdk:"resource_type"`
+}
+
+type recommendationData struct {
+	AccountID              types.String                                              `tfsdk:"account_id"`
+	CurrentInstanceType    types.String                                              `tfsdk:"current_instance_type"`
+	CurrentPerformanceRisk types.String                                              `tfsdk:"current_performance_risk"`
+	Finding                types.String                                              `tfsdk:"finding"`
+	LastRefreshTimestamp   types.String                                              `tfsdk:"last_refresh_timestamp"`
+	LookBackPeriodInDays   types.Float64                                             `tfsdk:"look_back_period_in_days"`
+	RecommendationOptions  fwtypes.ListNestedObjectValueOf[recommendationOptionData] `tfsdk:"recommendation_options"`
+	ResourceARN            types.String                                              `tfsdk:"resource_arn"`
+	ResourceName           types.String                                              `tfsdk:"resource_name"`
+}
+
+type recommendationOptionData struct {
+	EstimatedMonthlySavingsCurrency types.String  `tfsdk:"estimated_monthly_savings_currency"`
+	EstimatedMonthlySavingsValue    types.Float64 `tfsdk:"estimated_monthly_savings_value"`
+	InstanceType                    types.String  `tfsdk:"instance_type"`
+	MigrationEffort                 types.String  `tfsdk:"migration_effort"`
+	PerformanceRisk                 types.Float64 `tfsdk:"performance_risk"`
+	Rank                            types.Int64   `tfsdk:"rank"`
+	SavingsOpportunityPercentage    types.Float64 `tfsdk:"savings_opportunity_percentage"`
+}
+
+func findEC2InstanceRecommendations(ctx context.Context, conn *computeoptimizer.Client, input *computeoptimizer.GetEC2InstanceRecommendationsInput) ([]*recommendationData, error) {
+	var output []*recommendationData
+
+	for {
+		page, err := conn.GetEC2InstanceRecommendations(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.InstanceRecommendations {
+			options := make([]*recommendationOptionData, 0, len(v.RecommendationOptions))
+			for _, v := range v.RecommendationOptions {
+				options = append(options, flattenRecommendationOption(ctx, v.InstanceType, v.MigrationEffort, v.PerformanceRisk, v.Rank, v.SavingsOpportunity))
+			}
+
+			output = append(output, &recommendationData{
+				AccountID:              flex.StringToFramework(ctx, v.AccountId),
+				CurrentInstanceType:    flex.StringToFramework(ctx, v.CurrentInstanceType),
+				CurrentPerformanceRisk: flex.StringValueToFramework(ctx, v.CurrentPerformanceRisk),
+				Finding:                flex.StringValueToFramework(ctx, v.Finding),
+				LastRefreshTimestamp:   flattenTimestamp(v.LastRefreshTimestamp),
+				LookBackPeriodInDays:   types.Float64Value(v.LookBackPeriodInDays),
+				RecommendationOptions:  fwtypes.NewListNestedObjectValueOfSlice(ctx, options),
+				ResourceARN:            flex.StringToFramework(ctx, v.InstanceArn),
+				ResourceName:           flex.StringToFramework(ctx, v.InstanceName),
+			})
+		}
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+func findAutoScalingGroupRecommendations(ctx context.Context, conn *computeoptimizer.Client, input *computeoptimizer.GetAutoScalingGroupRecommendationsInput) ([]*recommendationData, error) {
+	var output []*recommendationData
+
+	for {
+		page, err := conn.GetAutoScalingGroupRecommendations(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.AutoScalingGroupRecommendations {
+			options := make([]*recommendationOptionData, 0, len(v.RecommendationOptions))
+			for _, v := range v.RecommendationOptions {
+				var instanceType *string
+				if v.Configuration != nil {
+					instanceType = v.Configuration.InstanceType
+				}
+
+				options = append(options, flattenRecommendationOption(ctx, instanceType, v.MigrationEffort, v.PerformanceRisk, v.Rank, v.SavingsOpportunity))
+			}
+
+			var currentInstanceType *string
+			if v.CurrentConfiguration != nil {
+				currentInstanceType = v.CurrentConfiguration.InstanceType
+			}
+
+			output = append(output, &recommendationData{
+				AccountID:              flex.StringToFramework(ctx, v.AccountId),
+				CurrentInstanceType:    flex.StringToFramework(ctx, currentInstanceType),
+				CurrentPerformanceRisk: flex.StringValueToFramework(ctx, v.CurrentPerformanceRisk),
+				Finding:                flex.StringValueToFramework(ctx, v.Finding),
+				LastRefreshTimestamp:   flattenTimestamp(v.LastRefreshTimestamp),
+				LookBackPeriodInDays:   types.Float64Value(v.LookBackPeriodInDays),
+				RecommendationOptions:  fwtypes.NewListNestedObjectValueOfSlice(ctx, options),
+				ResourceARN:            flex.StringToFramework(ctx, v.AutoScalingGroupArn),
+				ResourceName:           flex.StringToFramework(ctx, v.AutoScalingGroupName),
+			})
+		}
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+func flattenRecommendationOption(ctx context.Context, instanceType *string, migrationEffort awstypes.MigrationEffort, performanceRisk float64, rank int32, savingsOpportunity *awstypes.SavingsOpportunity) *recommendationOptionData {
+	data := &recommendationOptionData{
+		EstimatedMonthlySavingsCurrency: types.StringNull(),
+		EstimatedMonthlySavingsValue:    types.Float64Null(),
+		InstanceType:                    flex.StringToFramework(ctx, instanceType),
+		MigrationEffort:                 flex.StringValueToFramework(ctx, migrationEffort),
+		PerformanceRisk:                 types.Float64Value(performanceRisk),
+		Rank:                            types.Int64Value(int64(rank)),
+		SavingsOpportunityPercentage:    types.Float64Null(),
+	}
+
+	if savingsOpportunity != nil {
+		data.SavingsOpportunityPercentage = types.Float64Value(savingsOpportunity.SavingsOpportunityPercentage)
+
+		if v := savingsOpportunity.EstimatedMonthlySavings; v != nil {
+			data.EstimatedMonthlySavingsCurrency = flex.StringValueToFramework(ctx, v.Currency)
+			data.EstimatedMonthlySavingsValue = types.Float64Value(v.Value)
+		}
+	}
+
+	return data
+}
+
+func flattenTimestamp(v *time.Time) types.String {
+	if v == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(aws.ToTime(v).Format(time.RFC3339))
+}
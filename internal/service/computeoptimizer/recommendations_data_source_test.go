@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccRecommendationsDataSource_ec2Instance(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_computeoptimizer_recommendations.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizer),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationsDataSourceConfig_ec2Instance(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "Ec2Instance"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "recommendations.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_type", "Ec2Instance"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecommendationsDataSourceConfig_ec2Instance() string {
+	return `
+resource "aws_computeoptimizer_enrollment_status" "test" {
+  status = "Active"
+}
+
+data "aws_computeoptimizer_recommendations" "test" {
+  resource_type = "Ec2Instance"
+
+  depends_on = [aws_computeoptimizer_enrollment_status.test]
+}
+`
+}
@@ -0,0 +1,465 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docdbelastic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/docdbelastic"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/docdbelastic/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Cluster")
+// @Tags(identifierAttribute="arn")
+func newResourceCluster(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceCluster{}
+	r.SetDefaultCreateTimeout(45 * time.Minute)
+	r.SetDefaultUpdateTimeout(45 * time.Minute)
+	r.SetDefaultDeleteTimeout(45 * time.Minute)
+
+	return r, nil
+}
+
+type resourceCluster struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceCluster) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_docdbelastic_cluster"
+}
+
+func (r *resourceCluster) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"admin_user_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"admin_user_password": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"auth_type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.Auth](),
+				},
+			},
+			"endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"kms_key_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"preferred_maintenance_window": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"shard_capacity": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(2, 4, 8, 16, 32, 64),
+				},
+			},
+			"shard_count": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 32),
+				},
+			},
+			"subnet_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"vpc_security_group_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceCluster) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceClusterData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DocDBElasticClient(ctx)
+
+	input := &docdbelastic.CreateClusterInput{}
+
+	response.Diagnostics.Append(flex.Expand(ctx, &data, input)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateCluster(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DocDB Elastic Cluster (%s)", data.ClusterName.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ID = flex.StringToFramework(ctx, output.Cluster.ClusterArn)
+
+	cluster, err := waitClusterCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for DocDB Elastic Cluster (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(flex.Flatten(ctx, cluster, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCluster) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceClusterData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DocDBElasticClient(ctx)
+
+	cluster, err := findClusterByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DocDB Elastic Cluster (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, cluster, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCluster) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceClusterData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DocDBElasticClient(ctx)
+
+	if !new.AdminUserPassword.Equal(old.AdminUserPassword) ||
+		!new.AuthType.Equal(old.AuthType) ||
+		!new.PreferredMaintenanceWindow.Equal(old.PreferredMaintenanceWindow) ||
+		!new.ShardCapacity.Equal(old.ShardCapacity) ||
+		!new.ShardCount.Equal(old.ShardCount) ||
+		!new.SubnetIds.Equal(old.SubnetIds) ||
+		!new.VpcSecurityGroupIds.Equal(old.VpcSecurityGroupIds) {
+		input := &docdbelastic.UpdateClusterInput{
+			ClientToken: aws.String(id.UniqueId()),
+			ClusterArn:  flex.StringFromFramework(ctx, new.ID),
+		}
+
+		if !new.AdminUserPassword.Equal(old.AdminUserPassword) {
+			input.AdminUserPassword = flex.StringFromFramework(ctx, new.AdminUserPassword)
+		}
+
+		if !new.AuthType.Equal(old.AuthType) {
+			input.AuthType = awstypes.Auth(new.AuthType.ValueString())
+		}
+
+		if !new.PreferredMaintenanceWindow.Equal(old.PreferredMaintenanceWindow) {
+			input.PreferredMaintenanceWindow = flex.StringFromFramework(ctx, new.PreferredMaintenanceWindow)
+		}
+
+		if !new.ShardCapacity.Equal(old.ShardCapacity) {
+			input.ShardCapacity = aws.Int32(int32(new.ShardCapacity.ValueInt64()))
+		}
+
+		if !new.ShardCount.Equal(old.ShardCount) {
+			input.ShardCount = aws.Int32(int32(new.ShardCount.ValueInt64()))
+		}
+
+		if !new.SubnetIds.Equal(old.SubnetIds) {
+			input.SubnetIds = flex.ExpandFrameworkStringValueSet(ctx, new.SubnetIds)
+		}
+
+		if !new.VpcSecurityGroupIds.Equal(old.VpcSecurityGroupIds) {
+			input.VpcSecurityGroupIds = flex.ExpandFrameworkStringValueSet(ctx, new.VpcSecurityGroupIds)
+		}
+
+		_, err := conn.UpdateCluster(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating DocDB Elastic Cluster (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		cluster, err := waitClusterUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for DocDB Elastic Cluster (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(flex.Flatten(ctx, cluster, &new)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceCluster) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceClusterData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DocDBElasticClient(ctx)
+
+	_, err := conn.DeleteCluster(ctx, &docdbelastic.DeleteClusterInput{
+		ClusterArn: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DocDB Elastic Cluster (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitClusterDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for DocDB Elastic Cluster (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceCluster) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type resourceClusterData struct {
+	AdminUserName              types.String   `tfsdk:"admin_user_name"`
+	AdminUserPassword          types.String   `tfsdk:"admin_user_password"`
+	AuthType                   types.String   `tfsdk:"auth_type"`
+	ClusterArn                 types.String   `tfsdk:"arn"`
+	ClusterEndpoint            types.String   `tfsdk:"endpoint"`
+	ClusterName                types.String   `tfsdk:"name"`
+	ID                         types.String   `tfsdk:"id"`
+	KmsKeyId                   types.String   `tfsdk:"kms_key_id"`
+	PreferredMaintenanceWindow types.String   `tfsdk:"preferred_maintenance_window"`
+	ShardCapacity              types.Int64    `tfsdk:"shard_capacity"`
+	ShardCount                 types.Int64    `tfsdk:"shard_count"`
+	SubnetIds                  types.Set      `tfsdk:"subnet_ids"`
+	Tags                       types.Map      `tfsdk:"tags"`
+	TagsAll                    types.Map      `tfsdk:"tags_all"`
+	Timeouts                   timeouts.Value `tfsdk:"timeouts"`
+	VpcSecurityGroupIds        types.Set      `tfsdk:"vpc_security_group_ids"`
+}
+
+func findClusterByARN(ctx context.Context, conn *docdbelastic.Client, arn string) (*awstypes.Cluster, error) {
+	input := &docdbelastic.GetClusterInput{
+		ClusterArn: aws.String(arn),
+	}
+
+	output, err := conn.GetCluster(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Cluster == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Cluster, nil
+}
+
+func statusCluster(ctx context.Context, conn *docdbelastic.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findClusterByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitClusterCreated(ctx context.Context, conn *docdbelastic.Client, arn string, timeout time.Duration) (*awstypes.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.StatusCreating),
+		Target:     enum.Slice(awstypes.StatusActive),
+		Refresh:    statusCluster(ctx, conn, arn),
+		Timeout:    timeout,
+		Delay:      1 * time.Minute,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStatus(ctx, stateConf)
+
+	if output, ok := outputRaw.(*awstypes.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitClusterUpdated(ctx context.Context, conn *docdbelastic.Client, arn string, timeout time.Duration) (*awstypes.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.StatusUpdating),
+		Target:                    enum.Slice(awstypes.StatusActive),
+		Refresh:                   statusCluster(ctx, conn, arn),
+		Timeout:                   timeout,
+		Delay:                     1 * time.Minute,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := tfresource.WaitForStatus(ctx, stateConf)
+
+	if output, ok := outputRaw.(*awstypes.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitClusterDeleted(ctx context.Context, conn *docdbelastic.Client, arn string, timeout time.Duration) (*awstypes.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.StatusActive, awstypes.StatusDeleting),
+		Target:     []string{},
+		Refresh:    statusCluster(ctx, conn, arn),
+		Timeout:    timeout,
+		Delay:      1 * time.Minute,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStatus(ctx, stateConf)
+
+	if output, ok := outputRaw.(*awstypes.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
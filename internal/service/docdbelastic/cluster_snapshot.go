@@ -0,0 +1,359 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docdbelastic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/docdbelastic"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/docdbelastic/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Cluster Snapshot")
+// @Tags(identifierAttribute="arn")
+func newResourceClusterSnapshot(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceClusterSnapshot{}
+	r.SetDefaultCreateTimeout(20 * time.Minute)
+	r.SetDefaultDeleteTimeout(20 * time.Minute)
+
+	return r, nil
+}
+
+type resourceClusterSnapshot struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceClusterSnapshot) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_docdbelastic_cluster_snapshot"
+}
+
+func (r *resourceClusterSnapshot) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"admin_user_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"cluster_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cluster_creation_time": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"kms_key_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"snapshot_creation_time": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"snapshot_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subnet_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"vpc_security_group_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceClusterSnapshot) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceClusterSnapshotData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DocDBElasticClient(ctx)
+
+	input := &docdbelastic.CreateClusterSnapshotInput{}
+
+	response.Diagnostics.Append(flex.Expand(ctx, &data, input)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateClusterSnapshot(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DocDB Elastic Cluster Snapshot (%s)", data.SnapshotName.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ID = flex.StringToFramework(ctx, output.Snapshot.SnapshotArn)
+
+	snapshot, err := waitClusterSnapshotCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for DocDB Elastic Cluster Snapshot (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(flex.Flatten(ctx, snapshot, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceClusterSnapshot) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceClusterSnapshotData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DocDBElasticClient(ctx)
+
+	snapshot, err := findClusterSnapshotByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DocDB Elastic Cluster Snapshot (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, snapshot, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceClusterSnapshot) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new resourceClusterSnapshotData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Tags only.
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceClusterSnapshot) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceClusterSnapshotData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DocDBElasticClient(ctx)
+
+	_, err := conn.DeleteClusterSnapshot(ctx, &docdbelastic.DeleteClusterSnapshotInput{
+		SnapshotArn: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DocDB Elastic Cluster Snapshot (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitClusterSnapshotDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for DocDB Elastic Cluster Snapshot (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceClusterSnapshot) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type resourceClusterSnapshotData struct {
+	AdminUserName        types.String   `tfsdk:"admin_user_name"`
+	ClusterArn           fwtypes.ARN    `tfsdk:"cluster_arn"`
+	ClusterCreationTime  types.String   `tfsdk:"cluster_creation_time"`
+	ID                   types.String   `tfsdk:"id"`
+	KmsKeyId             types.String   `tfsdk:"kms_key_id"`
+	SnapshotArn          types.String   `tfsdk:"arn"`
+	SnapshotCreationTime types.String   `tfsdk:"snapshot_creation_time"`
+	SnapshotName         types.String   `tfsdk:"snapshot_name"`
+	Status               types.String   `tfsdk:"status"`
+	SubnetIds            types.Set      `tfsdk:"subnet_ids"`
+	Tags                 types.Map      `tfsdk:"tags"`
+	TagsAll              types.Map      `tfsdk:"tags_all"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+	VpcSecurityGroupIds  types.Set      `tfsdk:"vpc_security_group_ids"`
+}
+
+func findClusterSnapshotByARN(ctx context.Context, conn *docdbelastic.Client, arn string) (*awstypes.ClusterSnapshot, error) {
+	input := &docdbelastic.GetClusterSnapshotInput{
+		SnapshotArn: aws.String(arn),
+	}
+
+	output, err := conn.GetClusterSnapshot(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Snapshot == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Snapshot, nil
+}
+
+func statusClusterSnapshot(ctx context.Context, conn *docdbelastic.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findClusterSnapshotByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitClusterSnapshotCreated(ctx context.Context, conn *docdbelastic.Client, arn string, timeout time.Duration) (*awstypes.ClusterSnapshot, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.StatusCreating),
+		Target:     enum.Slice(awstypes.StatusActive),
+		Refresh:    statusClusterSnapshot(ctx, conn, arn),
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStatus(ctx, stateConf)
+
+	if output, ok := outputRaw.(*awstypes.ClusterSnapshot); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitClusterSnapshotDeleted(ctx context.Context, conn *docdbelastic.Client, arn string, timeout time.Duration) (*awstypes.ClusterSnapshot, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.StatusActive, awstypes.StatusDeleting),
+		Target:     []string{},
+		Refresh:    statusClusterSnapshot(ctx, conn, arn),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStatus(ctx, stateConf)
+
+	if output, ok := outputRaw.(*awstypes.ClusterSnapshot); ok {
+		return output, err
+	}
+
+	return nil, err
+}
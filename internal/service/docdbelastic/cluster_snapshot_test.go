@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docdbelastic_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/docdbelastic/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdocdbelastic "github.com/hashicorp/terraform-provider-aws/internal/service/docdbelastic"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDocDBElasticClusterSnapshot_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var snapshot awstypes.ClusterSnapshot
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_docdbelastic_cluster_snapshot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DocDBElastic)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBElastic),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterSnapshotDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterSnapshotConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterSnapshotExists(ctx, resourceName, &snapshot),
+					resource.TestCheckResourceAttr(resourceName, "admin_user_name", "testuser"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "docdb-elastic", regexp.MustCompile(`cluster-snapshot/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_arn", "aws_docdbelastic_cluster.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "snapshot_creation_time"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDocDBElasticClusterSnapshot_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var snapshot awstypes.ClusterSnapshot
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_docdbelastic_cluster_snapshot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DocDBElastic)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DocDBElastic),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterSnapshotDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterSnapshotConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterSnapshotExists(ctx, resourceName, &snapshot),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdocdbelastic.ResourceClusterSnapshot, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckClusterSnapshotDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DocDBElasticClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_docdbelastic_cluster_snapshot" {
+				continue
+			}
+
+			_, err := tfdocdbelastic.FindClusterSnapshotByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DocDB Elastic Cluster Snapshot %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckClusterSnapshotExists(ctx context.Context, n string, v *awstypes.ClusterSnapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DocDBElasticClient(ctx)
+
+		output, err := tfdocdbelastic.FindClusterSnapshotByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccClusterSnapshotConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_basic(rName, 2, 1), fmt.Sprintf(`
+resource "aws_docdbelastic_cluster_snapshot" "test" {
+  cluster_arn   = aws_docdbelastic_cluster.test.arn
+  snapshot_name = %[1]q
+}
+`, rName))
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build sweep
+// +build sweep
+
+package docdbelastic
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/docdbelastic"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_docdbelastic_cluster", &resource.Sweeper{
+		Name: "aws_docdbelastic_cluster",
+		F:    sweepClusters,
+	})
+
+	resource.AddTestSweepers("aws_docdbelastic_cluster_snapshot", &resource.Sweeper{
+		Name: "aws_docdbelastic_cluster_snapshot",
+		F:    sweepClusterSnapshots,
+	})
+}
+
+func sweepClusters(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.DocDBElasticClient(ctx)
+	input := &docdbelastic.ListClustersInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := docdbelastic.NewListClustersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping DocDB Elastic Cluster sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing DocDB Elastic Clusters (%s): %w", region, err)
+		}
+
+		for _, v := range page.Clusters {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newResourceCluster, client,
+				framework.NewAttribute("id", aws.ToString(v.ClusterArn)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping DocDB Elastic Clusters (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepClusterSnapshots(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.DocDBElasticClient(ctx)
+	input := &docdbelastic.ListClusterSnapshotsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := docdbelastic.NewListClusterSnapshotsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping DocDB Elastic Cluster Snapshot sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing DocDB Elastic Cluster Snapshots (%s): %w", region, err)
+		}
+
+		for _, v := range page.Snapshots {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newResourceClusterSnapshot, client,
+				framework.NewAttribute("id", aws.ToString(v.SnapshotArn)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping DocDB Elastic Cluster Snapshots (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -5,5 +5,8 @@ package dynamodb
 
 // Exports for use in tests only.
 var (
-	ListTags = listTags
+	ExpandTableItemsByKey   = expandTableItemsByKey
+	ListTags                = listTags
+	TableItemHash           = tableItemHash
+	TableItemsWriteRequests = tableItemsWriteRequests
 )
@@ -69,6 +69,56 @@ func FindTableByName(ctx context.Context, conn *dynamodb.DynamoDB, name string)
 	return output.Table, nil
 }
 
+func findImportByARN(ctx context.Context, conn *dynamodb.DynamoDB, arn string) (*dynamodb.ImportTableDescription, error) {
+	input := &dynamodb.DescribeImportInput{
+		ImportArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeImportWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeImportNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ImportTableDescription == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ImportTableDescription, nil
+}
+
+func FindTableExportByARN(ctx context.Context, conn *dynamodb.DynamoDB, arn string) (*dynamodb.ExportDescription, error) {
+	input := &dynamodb.DescribeExportInput{
+		ExportArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeExportWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeExportNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ExportDescription == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ExportDescription, nil
+}
+
 func findGSIByTwoPartKey(ctx context.Context, conn *dynamodb.DynamoDB, tableName, indexName string) (*dynamodb.GlobalSecondaryIndexDescription, error) {
 	table, err := FindTableByName(ctx, conn, tableName)
 
@@ -58,10 +58,20 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceTableExport,
+			TypeName: "aws_dynamodb_table_export",
+			Name:     "Table Export",
+		},
 		{
 			Factory:  ResourceTableItem,
 			TypeName: "aws_dynamodb_table_item",
 		},
+		{
+			Factory:  ResourceTableItems,
+			TypeName: "aws_dynamodb_table_items",
+			Name:     "Table Items",
+		},
 		{
 			Factory:  ResourceTableReplica,
 			TypeName: "aws_dynamodb_table_replica",
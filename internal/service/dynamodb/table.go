@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -198,6 +199,98 @@ func ResourceTable() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"import_table": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"local_secondary_index", "restore_source_name"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"error_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"import_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"imported_item_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"input_compression_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(dynamodb.InputCompressionType_Values(), false),
+						},
+						"input_format": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(dynamodb.InputFormat_Values(), false),
+						},
+						"input_format_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"csv": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delimiter": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"header_list": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"s3_bucket_source": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"bucket_owner": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"key_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"local_secondary_index": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -486,6 +579,91 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		if err != nil {
 			return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTable, tableName, err)
 		}
+	} else if v, ok := d.GetOk("import_table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		billingMode := d.Get("billing_mode").(string)
+
+		tableCreationParameters := &dynamodb.TableCreationParameters{
+			BillingMode: aws.String(billingMode),
+			KeySchema:   expandKeySchema(keySchemaMap),
+			TableName:   aws.String(tableName),
+		}
+
+		capacityMap := map[string]interface{}{
+			"write_capacity": d.Get("write_capacity"),
+			"read_capacity":  d.Get("read_capacity"),
+		}
+
+		tableCreationParameters.ProvisionedThroughput = expandProvisionedThroughput(capacityMap, billingMode)
+
+		if v, ok := d.GetOk("attribute"); ok {
+			aSet := v.(*schema.Set)
+			tableCreationParameters.AttributeDefinitions = expandAttributes(aSet.List())
+		}
+
+		if v, ok := d.GetOk("global_secondary_index"); ok {
+			globalSecondaryIndexes := []*dynamodb.GlobalSecondaryIndex{}
+			gsiSet := v.(*schema.Set)
+
+			for _, gsiObject := range gsiSet.List() {
+				gsi := gsiObject.(map[string]interface{})
+				if err := validateGSIProvisionedThroughput(gsi, billingMode); err != nil {
+					return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTable, tableName, err)
+				}
+
+				gsiObject := expandGlobalSecondaryIndex(gsi, billingMode)
+				globalSecondaryIndexes = append(globalSecondaryIndexes, gsiObject)
+			}
+			tableCreationParameters.GlobalSecondaryIndexes = globalSecondaryIndexes
+		}
+
+		if v, ok := d.GetOk("server_side_encryption"); ok {
+			tableCreationParameters.SSESpecification = expandEncryptAtRestOptions(v.([]interface{}))
+		}
+
+		input := expandImportTable(tfMap)
+		input.ClientToken = aws.String(id.UniqueId())
+		input.TableCreationParameters = tableCreationParameters
+
+		output, err := conn.ImportTableWithContext(ctx, input)
+
+		if err != nil {
+			return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTable, tableName, err)
+		}
+
+		// The table now exists, so record it in state before waiting; a failed import taints the resource.
+		d.SetId(tableName)
+
+		importARN := aws.StringValue(output.ImportTableDescription.ImportArn)
+		tfMap["import_arn"] = importARN
+
+		if err := d.Set("import_table", []interface{}{tfMap}); err != nil {
+			return create.DiagSettingError(names.DynamoDB, ResNameTable, tableName, "import_table", err)
+		}
+
+		importTableDescription, err := waitImportComplete(ctx, conn, importARN, d.Timeout(schema.TimeoutCreate))
+
+		if err != nil {
+			return create.DiagError(names.DynamoDB, create.ErrActionWaitingForCreation, ResNameTable, tableName, fmt.Errorf("import (%s): %w", importARN, err))
+		}
+
+		tfMap["error_count"] = int(aws.Int64Value(importTableDescription.ErrorCount))
+		tfMap["imported_item_count"] = int(aws.Int64Value(importTableDescription.ImportedItemCount))
+
+		if err := d.Set("import_table", []interface{}{tfMap}); err != nil {
+			return create.DiagSettingError(names.DynamoDB, ResNameTable, tableName, "import_table", err)
+		}
+
+		// ImportTable does not accept tags, streams, deletion protection or table class.
+		if tags := getTagsIn(ctx); len(tags) > 0 {
+			if err := updateTags(ctx, conn, aws.StringValue(importTableDescription.TableArn), nil, KeyValueTags(ctx, tags)); err != nil {
+				return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTable, tableName, fmt.Errorf("setting tags: %w", err))
+			}
+		}
+
+		if err := updateImportedTable(ctx, conn, d, tableName); err != nil {
+			return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTable, tableName, err)
+		}
 	} else {
 		input := &dynamodb.CreateTableInput{
 			BillingMode: aws.String(d.Get("billing_mode").(string)),
@@ -1043,6 +1221,61 @@ func resourceTableDelete(ctx context.Context, d *schema.ResourceData, meta inter
 
 // custom diff
 
+// updateImportedTable applies the settings that ImportTable cannot set at creation time.
+func updateImportedTable(ctx context.Context, conn *dynamodb.DynamoDB, d *schema.ResourceData, tableName string) error {
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	if _, err := waitTableActive(ctx, conn, tableName, timeout); err != nil {
+		return fmt.Errorf("waiting for import completion: %w", err)
+	}
+
+	hasTableUpdate := false
+	input := &dynamodb.UpdateTableInput{
+		TableName: aws.String(tableName),
+	}
+
+	if v, ok := d.GetOk("deletion_protection_enabled"); ok {
+		hasTableUpdate = true
+		input.DeletionProtectionEnabled = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("stream_enabled"); ok {
+		hasTableUpdate = true
+		input.StreamSpecification = &dynamodb.StreamSpecification{
+			StreamEnabled:  aws.Bool(v.(bool)),
+			StreamViewType: aws.String(d.Get("stream_view_type").(string)),
+		}
+	}
+
+	if hasTableUpdate {
+		if _, err := conn.UpdateTableWithContext(ctx, input); err != nil {
+			return err
+		}
+
+		if _, err := waitTableActive(ctx, conn, tableName, timeout); err != nil {
+			return fmt.Errorf("waiting for update: %w", err)
+		}
+	}
+
+	// Table Class cannot be changed concurrently with other values
+	if v, ok := d.GetOk("table_class"); ok && v.(string) != dynamodb.TableClassStandard {
+		_, err := conn.UpdateTableWithContext(ctx, &dynamodb.UpdateTableInput{
+			TableName:  aws.String(tableName),
+			TableClass: aws.String(v.(string)),
+		})
+
+		if err != nil {
+			return fmt.Errorf("updating table class: %w", err)
+		}
+
+		if _, err := waitTableActive(ctx, conn, tableName, timeout); err != nil {
+			return fmt.Errorf("updating table class: waiting for completion: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func isTableOptionDisabled(v interface{}) bool {
 	options := v.([]interface{})
 	if len(options) == 0 {
@@ -1917,6 +2150,67 @@ func flattenTableServerSideEncryption(description *dynamodb.SSEDescription) []in
 	return []interface{}{m}
 }
 
+func expandImportTable(tfMap map[string]interface{}) *dynamodb.ImportTableInput {
+	apiObject := &dynamodb.ImportTableInput{}
+
+	if v, ok := tfMap["input_compression_type"].(string); ok && v != "" {
+		apiObject.InputCompressionType = aws.String(v)
+	}
+
+	if v, ok := tfMap["input_format"].(string); ok && v != "" {
+		apiObject.InputFormat = aws.String(v)
+	}
+
+	if v, ok := tfMap["input_format_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.InputFormatOptions = expandInputFormatOptions(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_bucket_source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3BucketSource = expandS3BucketSource(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandInputFormatOptions(tfMap map[string]interface{}) *dynamodb.InputFormatOptions {
+	apiObject := &dynamodb.InputFormatOptions{}
+
+	if v, ok := tfMap["csv"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		csv := &dynamodb.CsvOptions{}
+
+		if v, ok := tfMap["delimiter"].(string); ok && v != "" {
+			csv.Delimiter = aws.String(v)
+		}
+
+		if v, ok := tfMap["header_list"].([]interface{}); ok && len(v) > 0 {
+			csv.HeaderList = flex.ExpandStringList(v)
+		}
+
+		apiObject.Csv = csv
+	}
+
+	return apiObject
+}
+
+func expandS3BucketSource(tfMap map[string]interface{}) *dynamodb.S3BucketSource {
+	apiObject := &dynamodb.S3BucketSource{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.S3Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["bucket_owner"].(string); ok && v != "" {
+		apiObject.S3BucketOwner = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+		apiObject.S3KeyPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func expandAttributes(cfg []interface{}) []*dynamodb.AttributeDefinition {
 	attributes := make([]*dynamodb.AttributeDefinition, len(cfg))
 	for i, attribute := range cfg {
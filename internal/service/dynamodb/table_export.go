@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameTableExport = "Table Export"
+)
+
+// @SDKResource("aws_dynamodb_table_export", name="Table Export")
+func ResourceTableExport() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTableExportCreate,
+		ReadWithoutTimeout:   resourceTableExportRead,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"billed_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"export_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      dynamodb.ExportFormatDynamodbJson,
+				ValidateFunc: validation.StringInSlice(dynamodb.ExportFormat_Values(), false),
+			},
+			"export_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"export_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Second),
+			},
+			"item_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"manifest_files_s3_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"s3_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"s3_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"s3_sse_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(dynamodb.S3SseAlgorithm_Values(), false),
+			},
+			"s3_sse_kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceTableExportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	tableARN := d.Get("table_arn").(string)
+	input := &dynamodb.ExportTableToPointInTimeInput{
+		ClientToken:  aws.String(id.UniqueId()),
+		ExportFormat: aws.String(d.Get("export_format").(string)),
+		S3Bucket:     aws.String(d.Get("s3_bucket").(string)),
+		TableArn:     aws.String(tableARN),
+	}
+
+	if v, ok := d.GetOk("export_time"); ok {
+		t, err := time.Parse(time.RFC3339, v.(string))
+
+		if err != nil {
+			return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTableExport, tableARN, err)
+		}
+
+		input.ExportTime = aws.Time(t)
+	}
+
+	if v, ok := d.GetOk("s3_bucket_owner"); ok {
+		input.S3BucketOwner = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("s3_prefix"); ok {
+		input.S3Prefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("s3_sse_algorithm"); ok {
+		input.S3SseAlgorithm = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("s3_sse_kms_key_id"); ok {
+		input.S3SseKmsKeyId = aws.String(v.(string))
+	}
+
+	output, err := conn.ExportTableToPointInTimeWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTableExport, tableARN, err)
+	}
+
+	d.SetId(aws.StringValue(output.ExportDescription.ExportArn))
+
+	if _, err := waitTableExportCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.DynamoDB, create.ErrActionWaitingForCreation, ResNameTableExport, d.Id(), err)
+	}
+
+	return resourceTableExportRead(ctx, d, meta)
+}
+
+func resourceTableExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	desc, err := FindTableExportByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.DynamoDB, create.ErrActionReading, ResNameTableExport, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.DynamoDB, create.ErrActionReading, ResNameTableExport, d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, desc.ExportArn)
+	d.Set("billed_size_in_bytes", desc.BilledSizeBytes)
+	d.Set("export_format", desc.ExportFormat)
+	d.Set("export_status", desc.ExportStatus)
+	d.Set("item_count", desc.ItemCount)
+	d.Set("manifest_files_s3_key", desc.ExportManifest)
+	d.Set("s3_bucket", desc.S3Bucket)
+	d.Set("s3_bucket_owner", desc.S3BucketOwner)
+	d.Set("s3_prefix", desc.S3Prefix)
+	d.Set("s3_sse_algorithm", desc.S3SseAlgorithm)
+	d.Set("s3_sse_kms_key_id", desc.S3SseKmsKeyId)
+	d.Set("table_arn", desc.TableArn)
+
+	if desc.EndTime != nil {
+		d.Set("end_time", aws.TimeValue(desc.EndTime).Format(time.RFC3339))
+	}
+
+	if desc.ExportTime != nil {
+		d.Set("export_time", aws.TimeValue(desc.ExportTime).Format(time.RFC3339))
+	}
+
+	if desc.StartTime != nil {
+		d.Set("start_time", aws.TimeValue(desc.StartTime).Format(time.RFC3339))
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDynamoDBTableExport_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var export dynamodb.ExportDescription
+	resourceName := "aws_dynamodb_table_export.test"
+	tableResourceName := "aws_dynamodb_table.test"
+	s3BucketResourceName := "aws_s3_bucket.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableExportConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExportExists(ctx, resourceName, &export),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "dynamodb", regexp.MustCompile(`table/.+/export/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "end_time"),
+					resource.TestCheckResourceAttr(resourceName, "export_format", "DYNAMODB_JSON"),
+					resource.TestCheckResourceAttr(resourceName, "export_status", "COMPLETED"),
+					resource.TestCheckResourceAttrSet(resourceName, "export_time"),
+					resource.TestCheckResourceAttr(resourceName, "item_count", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest_files_s3_key"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_bucket", s3BucketResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "s3_sse_algorithm", "AES256"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttrPair(resourceName, "table_arn", tableResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableExport_kms(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var export dynamodb.ExportDescription
+	resourceName := "aws_dynamodb_table_export.test"
+	kmsKeyResourceName := "aws_kms_key.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableExportConfig_kms(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExportExists(ctx, resourceName, &export),
+					resource.TestCheckResourceAttr(resourceName, "export_format", "ION"),
+					resource.TestCheckResourceAttr(resourceName, "export_status", "COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "s3_prefix", "exports"),
+					resource.TestCheckResourceAttr(resourceName, "s3_sse_algorithm", "KMS"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_sse_kms_key_id", kmsKeyResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTableExportExists(ctx context.Context, n string, v *dynamodb.ExportDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DynamoDB Table Export ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn(ctx)
+
+		output, err := tfdynamodb.FindTableExportByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTableExportConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  point_in_time_recovery {
+    enabled = true
+  }
+}
+`, rName)
+}
+
+func testAccTableExportConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTableExportConfig_base(rName), `
+resource "aws_dynamodb_table_export" "test" {
+  table_arn = aws_dynamodb_table.test.arn
+  s3_bucket = aws_s3_bucket.test.id
+}
+`)
+}
+
+func testAccTableExportConfig_kms(rName string) string {
+	return acctest.ConfigCompose(testAccTableExportConfig_base(rName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_dynamodb_table_export" "test" {
+  export_format     = "ION"
+  s3_bucket         = aws_s3_bucket.test.id
+  s3_prefix         = "exports"
+  s3_sse_algorithm  = "KMS"
+  s3_sse_kms_key_id = aws_kms_key.test.id
+  table_arn         = aws_dynamodb_table.test.arn
+}
+`, rName))
+}
@@ -0,0 +1,389 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameTableItems = "Table Items"
+
+	// BatchWriteItem accepts at most 25 requests and BatchGetItem at most 100 keys per call.
+	batchWriteItemMaxRequests = 25
+	batchGetItemMaxKeys       = 100
+
+	batchGetItemTimeout = 5 * time.Minute
+)
+
+// @SDKResource("aws_dynamodb_table_items", name="Table Items")
+func ResourceTableItems() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTableItemsCreate,
+		ReadWithoutTimeout:   resourceTableItemsRead,
+		UpdateWithoutTimeout: resourceTableItemsUpdate,
+		DeleteWithoutTimeout: resourceTableItemsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"hash_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"items": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateTableItem,
+				},
+				Set: tableItemHash,
+			},
+			"range_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceTableItemsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	tableName := d.Get("table_name").(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	items, err := expandTableItemsByKey(d.Get("items").(*schema.Set).List(), tableName, hashKey, rangeKey)
+
+	if err != nil {
+		return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTableItems, tableName, err)
+	}
+
+	requests := tableItemsWriteRequests(nil, items, hashKey, rangeKey)
+
+	if err := batchWriteTableItems(ctx, conn, tableName, requests, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTableItems, tableName, err)
+	}
+
+	d.SetId(tableName)
+
+	return resourceTableItemsRead(ctx, d, meta)
+}
+
+func resourceTableItemsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	tableName := d.Get("table_name").(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+	rawItems := d.Get("items").(*schema.Set).List()
+
+	items, err := expandTableItemsByKey(rawItems, tableName, hashKey, rangeKey)
+
+	if err != nil {
+		return create.DiagError(names.DynamoDB, create.ErrActionReading, ResNameTableItems, d.Id(), err)
+	}
+
+	var keys []map[string]*dynamodb.AttributeValue
+	for _, attributes := range items {
+		keys = append(keys, BuildTableItemQueryKey(attributes, hashKey, rangeKey))
+	}
+
+	found, err := findTableItemsByKeys(ctx, conn, tableName, keys)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.DynamoDB, create.ErrActionReading, ResNameTableItems, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.DynamoDB, create.ErrActionReading, ResNameTableItems, d.Id(), err)
+	}
+
+	foundByID := make(map[string]map[string]*dynamodb.AttributeValue, len(found))
+	for _, attributes := range found {
+		foundByID[buildTableItemID(tableName, hashKey, rangeKey, attributes)] = attributes
+	}
+
+	// Items deleted outside of Terraform drop out of the set so that they are recreated.
+	var newItems []interface{}
+	for _, v := range rawItems {
+		item := v.(string)
+		attributes, _ := ExpandTableItemAttributes(item)
+		result, ok := foundByID[buildTableItemID(tableName, hashKey, rangeKey, attributes)]
+
+		if !ok {
+			continue
+		}
+
+		if reflect.DeepEqual(result, attributes) {
+			newItems = append(newItems, item)
+			continue
+		}
+
+		item, err := flattenTableItemAttributes(result)
+
+		if err != nil {
+			return create.DiagError(names.DynamoDB, create.ErrActionReading, ResNameTableItems, d.Id(), err)
+		}
+
+		newItems = append(newItems, item)
+	}
+
+	if err := d.Set("items", schema.NewSet(tableItemHash, newItems)); err != nil {
+		return create.DiagSettingError(names.DynamoDB, ResNameTableItems, d.Id(), "items", err)
+	}
+
+	return nil
+}
+
+func resourceTableItemsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	if d.HasChange("items") {
+		tableName := d.Get("table_name").(string)
+		hashKey := d.Get("hash_key").(string)
+		rangeKey := d.Get("range_key").(string)
+
+		o, n := d.GetChange("items")
+
+		oldItems, err := expandTableItemsByKey(o.(*schema.Set).List(), tableName, hashKey, rangeKey)
+
+		if err != nil {
+			return create.DiagError(names.DynamoDB, create.ErrActionUpdating, ResNameTableItems, d.Id(), err)
+		}
+
+		newItems, err := expandTableItemsByKey(n.(*schema.Set).List(), tableName, hashKey, rangeKey)
+
+		if err != nil {
+			return create.DiagError(names.DynamoDB, create.ErrActionUpdating, ResNameTableItems, d.Id(), err)
+		}
+
+		requests := tableItemsWriteRequests(oldItems, newItems, hashKey, rangeKey)
+
+		if err := batchWriteTableItems(ctx, conn, tableName, requests, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.DiagError(names.DynamoDB, create.ErrActionUpdating, ResNameTableItems, d.Id(), err)
+		}
+	}
+
+	return resourceTableItemsRead(ctx, d, meta)
+}
+
+func resourceTableItemsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	tableName := d.Get("table_name").(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	items, err := expandTableItemsByKey(d.Get("items").(*schema.Set).List(), tableName, hashKey, rangeKey)
+
+	if err != nil {
+		return create.DiagError(names.DynamoDB, create.ErrActionDeleting, ResNameTableItems, d.Id(), err)
+	}
+
+	requests := tableItemsWriteRequests(items, nil, hashKey, rangeKey)
+
+	err = batchWriteTableItems(ctx, conn, tableName, requests, d.Timeout(schema.TimeoutDelete))
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.DynamoDB, create.ErrActionDeleting, ResNameTableItems, d.Id(), err)
+	}
+
+	return nil
+}
+
+// tableItemsWriteRequests returns the puts for new or changed items and the deletes for removed items.
+func tableItemsWriteRequests(oldItems, newItems map[string]map[string]*dynamodb.AttributeValue, hashKey, rangeKey string) []*dynamodb.WriteRequest {
+	var requests []*dynamodb.WriteRequest
+
+	for id, attributes := range newItems {
+		if old, ok := oldItems[id]; ok && reflect.DeepEqual(old, attributes) {
+			continue
+		}
+
+		requests = append(requests, &dynamodb.WriteRequest{
+			PutRequest: &dynamodb.PutRequest{
+				Item: attributes,
+			},
+		})
+	}
+
+	for id, attributes := range oldItems {
+		if _, ok := newItems[id]; ok {
+			continue
+		}
+
+		requests = append(requests, &dynamodb.WriteRequest{
+			DeleteRequest: &dynamodb.DeleteRequest{
+				Key: BuildTableItemQueryKey(attributes, hashKey, rangeKey),
+			},
+		})
+	}
+
+	return requests
+}
+
+// batchWriteTableItems sends the requests in batches, resubmitting any unprocessed items until the timeout elapses.
+func batchWriteTableItems(ctx context.Context, conn *dynamodb.DynamoDB, tableName string, requests []*dynamodb.WriteRequest, timeout time.Duration) error {
+	for _, chunk := range slices.Chunks(requests, batchWriteItemMaxRequests) {
+		input := &dynamodb.BatchWriteItemInput{
+			RequestItems: map[string][]*dynamodb.WriteRequest{
+				tableName: chunk,
+			},
+		}
+
+		err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
+			output, err := conn.BatchWriteItemWithContext(ctx, input)
+
+			if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeProvisionedThroughputExceededException, dynamodb.ErrCodeRequestLimitExceeded) {
+				return retry.RetryableError(err)
+			}
+
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			if n := len(output.UnprocessedItems[tableName]); n > 0 {
+				input.RequestItems = output.UnprocessedItems
+				return retry.RetryableError(fmt.Errorf("%d unprocessed items", n))
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func findTableItemsByKeys(ctx context.Context, conn *dynamodb.DynamoDB, tableName string, keys []map[string]*dynamodb.AttributeValue) ([]map[string]*dynamodb.AttributeValue, error) {
+	var output []map[string]*dynamodb.AttributeValue
+
+	for _, chunk := range slices.Chunks(keys, batchGetItemMaxKeys) {
+		input := &dynamodb.BatchGetItemInput{
+			RequestItems: map[string]*dynamodb.KeysAndAttributes{
+				tableName: {
+					ConsistentRead: aws.Bool(true),
+					Keys:           chunk,
+				},
+			},
+		}
+
+		err := tfresource.Retry(ctx, batchGetItemTimeout, func() *retry.RetryError {
+			page, err := conn.BatchGetItemWithContext(ctx, input)
+
+			if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeProvisionedThroughputExceededException, dynamodb.ErrCodeRequestLimitExceeded) {
+				return retry.RetryableError(err)
+			}
+
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			output = append(output, page.Responses[tableName]...)
+
+			if n := len(page.UnprocessedKeys[tableName].Keys); n > 0 {
+				input.RequestItems = page.UnprocessedKeys
+				return retry.RetryableError(fmt.Errorf("%d unprocessed keys", n))
+			}
+
+			return nil
+		})
+
+		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+// expandTableItemsByKey parses the configured items and indexes them by their key, rejecting items without the
+// table's key attributes and items that share a key.
+func expandTableItemsByKey(tfList []interface{}, tableName, hashKey, rangeKey string) (map[string]map[string]*dynamodb.AttributeValue, error) {
+	items := make(map[string]map[string]*dynamodb.AttributeValue, len(tfList))
+
+	for _, v := range tfList {
+		attributes, err := ExpandTableItemAttributes(v.(string))
+
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := attributes[hashKey]; !ok {
+			return nil, fmt.Errorf("item is missing hash key (%s): %s", hashKey, v)
+		}
+
+		if _, ok := attributes[rangeKey]; rangeKey != "" && !ok {
+			return nil, fmt.Errorf("item is missing range key (%s): %s", rangeKey, v)
+		}
+
+		id := buildTableItemID(tableName, hashKey, rangeKey, attributes)
+
+		if _, ok := items[id]; ok {
+			return nil, fmt.Errorf("duplicate item key: %s", v)
+		}
+
+		items[id] = attributes
+	}
+
+	return items, nil
+}
+
+// tableItemHash hashes the normalized JSON so that formatting differences do not cause a diff.
+func tableItemHash(v interface{}) int {
+	item := v.(string)
+
+	if normalized, err := structure.NormalizeJsonString(item); err == nil {
+		item = normalized
+	}
+
+	return create.StringHashcode(item)
+}
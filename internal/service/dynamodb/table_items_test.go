@@ -0,0 +1,456 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/google/go-cmp/cmp"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestTableItemHash(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		a, b  string
+		equal bool
+	}{
+		"identical": {
+			a:     `{"hashKey": {"S": "one"}}`,
+			b:     `{"hashKey": {"S": "one"}}`,
+			equal: true,
+		},
+		"whitespace": {
+			a:     `{"hashKey": {"S": "one"}, "value": {"N": "1"}}`,
+			b:     "{\n  \"hashKey\":{\"S\":\"one\"},\n  \"value\":{\"N\":\"1\"}\n}",
+			equal: true,
+		},
+		"attribute order": {
+			a:     `{"hashKey": {"S": "one"}, "value": {"N": "1"}}`,
+			b:     `{"value": {"N": "1"}, "hashKey": {"S": "one"}}`,
+			equal: true,
+		},
+		"different value": {
+			a:     `{"hashKey": {"S": "one"}, "value": {"N": "1"}}`,
+			b:     `{"hashKey": {"S": "one"}, "value": {"N": "2"}}`,
+			equal: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfdynamodb.TableItemHash(testCase.a) == tfdynamodb.TableItemHash(testCase.b); got != testCase.equal {
+				t.Errorf("hashes equal = %t, want %t", got, testCase.equal)
+			}
+		})
+	}
+}
+
+func TestTableItemsWriteRequests(t *testing.T) {
+	t.Parallel()
+
+	const (
+		tableName = "test"
+		hashKey   = "hashKey"
+		rangeKey  = ""
+	)
+
+	one := `{"hashKey": {"S": "one"}, "value": {"N": "1"}}`
+	oneUpdated := `{"hashKey": {"S": "one"}, "value": {"N": "11"}}`
+	two := `{"hashKey": {"S": "two"}, "value": {"N": "2"}}`
+	three := `{"hashKey": {"S": "three"}, "value": {"N": "3"}}`
+
+	testCases := map[string]struct {
+		oldItems        []interface{}
+		newItems        []interface{}
+		expectedPuts    []string
+		expectedDeletes []string
+	}{
+		"create": {
+			newItems:     []interface{}{one, two},
+			expectedPuts: []string{"one", "two"},
+		},
+		"delete": {
+			oldItems:        []interface{}{one, two},
+			expectedDeletes: []string{"one", "two"},
+		},
+		"no change": {
+			oldItems: []interface{}{one, two},
+			newItems: []interface{}{one, two},
+		},
+		"formatting only": {
+			oldItems: []interface{}{one},
+			newItems: []interface{}{`{"value":{"N":"1"},"hashKey":{"S":"one"}}`},
+		},
+		"add, change and remove": {
+			oldItems:        []interface{}{one, two},
+			newItems:        []interface{}{oneUpdated, three},
+			expectedPuts:    []string{"one", "three"},
+			expectedDeletes: []string{"two"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			oldItems, err := tfdynamodb.ExpandTableItemsByKey(testCase.oldItems, tableName, hashKey, rangeKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			newItems, err := tfdynamodb.ExpandTableItemsByKey(testCase.newItems, tableName, hashKey, rangeKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var puts, deletes []string
+			for _, request := range tfdynamodb.TableItemsWriteRequests(oldItems, newItems, hashKey, rangeKey) {
+				if request.PutRequest != nil {
+					puts = append(puts, aws.StringValue(request.PutRequest.Item[hashKey].S))
+				}
+				if request.DeleteRequest != nil {
+					if got, want := len(request.DeleteRequest.Key), 1; got != want {
+						t.Errorf("delete key has %d attributes, want %d", got, want)
+					}
+					deletes = append(deletes, aws.StringValue(request.DeleteRequest.Key[hashKey].S))
+				}
+			}
+			sort.Strings(puts)
+			sort.Strings(deletes)
+
+			if diff := cmp.Diff(puts, testCase.expectedPuts); diff != "" {
+				t.Errorf("unexpected puts (-got +want): %s", diff)
+			}
+			if diff := cmp.Diff(deletes, testCase.expectedDeletes); diff != "" {
+				t.Errorf("unexpected deletes (-got +want): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandTableItemsByKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		items       []interface{}
+		rangeKey    string
+		expectedErr bool
+	}{
+		"valid": {
+			items: []interface{}{`{"hashKey": {"S": "one"}}`, `{"hashKey": {"S": "two"}}`},
+		},
+		"missing hash key": {
+			items:       []interface{}{`{"other": {"S": "one"}}`},
+			expectedErr: true,
+		},
+		"missing range key": {
+			items:       []interface{}{`{"hashKey": {"S": "one"}}`},
+			rangeKey:    "rangeKey",
+			expectedErr: true,
+		},
+		"duplicate key": {
+			items:       []interface{}{`{"hashKey": {"S": "one"}, "value": {"N": "1"}}`, `{"hashKey": {"S": "one"}, "value": {"N": "2"}}`},
+			expectedErr: true,
+		},
+		"same hash key different range key": {
+			items:    []interface{}{`{"hashKey": {"S": "one"}, "rangeKey": {"S": "a"}}`, `{"hashKey": {"S": "one"}, "rangeKey": {"S": "b"}}`},
+			rangeKey: "rangeKey",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := tfdynamodb.ExpandTableItemsByKey(testCase.items, "test", "hashKey", testCase.rangeKey)
+
+			if got := err != nil; got != testCase.expectedErr {
+				t.Errorf("error = %v, want error %t", err, testCase.expectedErr)
+			}
+		})
+	}
+}
+
+func TestAccDynamoDBTableItems_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dynamodb_table_items.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	firstItem := `{"hashKey": {"S": "one"}, "value": {"N": "1"}}`
+	secondItem := `{"hashKey": {"S": "two"}, "value": {"N": "2"}}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, firstItem, secondItem),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsExist(ctx, resourceName),
+					testAccCheckTableItemCount(ctx, rName, 2),
+					resource.TestCheckResourceAttr(resourceName, "hash_key", "hashKey"),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "table_name", rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dynamodb_table_items.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	firstItem := `{"hashKey": {"S": "one"}, "value": {"N": "1"}}`
+	secondItem := `{"hashKey": {"S": "two"}, "value": {"N": "2"}}`
+	secondItemUpdated := `{"hashKey": {"S": "two"}, "value": {"N": "22"}}`
+	thirdItem := `{"hashKey": {"S": "three"}, "value": {"N": "3"}}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, firstItem, secondItem),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsExist(ctx, resourceName),
+					testAccCheckTableItemCount(ctx, rName, 2),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_basic(rName, secondItemUpdated, thirdItem),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsExist(ctx, resourceName),
+					testAccCheckTableItemCount(ctx, rName, 2),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_rangeKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dynamodb_table_items.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	firstItem := `{"hashKey": {"S": "one"}, "rangeKey": {"S": "a"}, "value": {"N": "1"}}`
+	secondItem := `{"hashKey": {"S": "one"}, "rangeKey": {"S": "b"}, "value": {"N": "2"}}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_rangeKey(rName, firstItem, secondItem),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsExist(ctx, resourceName),
+					testAccCheckTableItemCount(ctx, rName, 2),
+					resource.TestCheckResourceAttr(resourceName, "range_key", "rangeKey"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_manyItems(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dynamodb_table_items.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	// More items than fit in a single BatchWriteItem call.
+	var items []string
+	for i := 0; i < 60; i++ {
+		items = append(items, fmt.Sprintf(`{"hashKey": {"S": "item-%[1]d"}, "value": {"N": "%[1]d"}}`, i))
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, items...),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsExist(ctx, resourceName),
+					testAccCheckTableItemCount(ctx, rName, 60),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "60"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTableItemsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dynamodb_table_items" {
+				continue
+			}
+
+			attrs := rs.Primary.Attributes
+
+			for _, item := range testAccTableItemsFromState(rs) {
+				attributes, err := tfdynamodb.ExpandTableItemAttributes(item)
+				if err != nil {
+					return err
+				}
+
+				key := tfdynamodb.BuildTableItemQueryKey(attributes, attrs["hash_key"], attrs["range_key"])
+
+				_, err = tfdynamodb.FindTableItem(ctx, conn, attrs["table_name"], key)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("DynamoDB Table Items %s still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTableItemsExist(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DynamoDB Table Items ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn(ctx)
+
+		attrs := rs.Primary.Attributes
+
+		for _, item := range testAccTableItemsFromState(rs) {
+			attributes, err := tfdynamodb.ExpandTableItemAttributes(item)
+			if err != nil {
+				return err
+			}
+
+			key := tfdynamodb.BuildTableItemQueryKey(attributes, attrs["hash_key"], attrs["range_key"])
+
+			if _, err := tfdynamodb.FindTableItem(ctx, conn, attrs["table_name"], key); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccTableItemsFromState(rs *terraform.ResourceState) []string {
+	var items []string
+
+	for k, v := range rs.Primary.Attributes {
+		if strings.HasPrefix(k, "items.") && k != "items.#" {
+			items = append(items, v)
+		}
+	}
+
+	return items
+}
+
+func testAccTableItemsConfig_basic(rName string, items ...string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "hashKey"
+
+  attribute {
+    name = "hashKey"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  items = [
+%[2]s
+  ]
+}
+`, rName, testAccTableItemsConfigItems(items))
+}
+
+func testAccTableItemsConfig_rangeKey(rName string, items ...string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "hashKey"
+  range_key    = "rangeKey"
+
+  attribute {
+    name = "hashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "rangeKey"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+
+  items = [
+%[2]s
+  ]
+}
+`, rName, testAccTableItemsConfigItems(items))
+}
+
+func testAccTableItemsConfigItems(items []string) string {
+	lines := make([]string, 0, len(items))
+
+	for _, item := range items {
+		lines = append(lines, fmt.Sprintf("    %q,", item))
+	}
+
+	return strings.Join(lines, "\n")
+}
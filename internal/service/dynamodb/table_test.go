@@ -2546,6 +2546,47 @@ func TestAccDynamoDBTable_backup_overrideEncryption(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTable_importTable(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf dynamodb.TableDescription
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_importTable(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "import_table.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.error_count", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "import_table.0.import_arn"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.imported_item_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.input_format", "CSV"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.input_format_options.0.csv.0.delimiter", ","),
+					resource.TestCheckResourceAttr(resourceName, "stream_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"import_table"},
+			},
+		},
+	})
+}
+
 func testAccCheckTableDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn(ctx)
@@ -4409,3 +4450,54 @@ resource "aws_dynamodb_table" "test" {
 }
 `, rName)
 }
+
+func testAccTableConfig_importTable(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "import/data.csv"
+  content = <<EOF
+TestTableHashKey,Value
+one,1
+two,2
+EOF
+}
+
+resource "aws_dynamodb_table" "test" {
+  name             = %[1]q
+  billing_mode     = "PAY_PER_REQUEST"
+  hash_key         = "TestTableHashKey"
+  stream_enabled   = true
+  stream_view_type = "KEYS_ONLY"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  import_table {
+    input_format = "CSV"
+
+    input_format_options {
+      csv {
+        delimiter = ","
+      }
+    }
+
+    s3_bucket_source {
+      bucket     = aws_s3_object.test.bucket
+      key_prefix = "import/"
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
@@ -8,15 +8,19 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
 	kinesisStreamingDestinationActiveTimeout   = 5 * time.Minute
 	kinesisStreamingDestinationDisabledTimeout = 5 * time.Minute
 	createTableTimeout                         = 30 * time.Minute
+	importTableTimeout                         = 60 * time.Minute
+	exportTableTimeout                         = 60 * time.Minute
 	updateTableTimeoutTotal                    = 60 * time.Minute
 	replicaUpdateTimeout                       = 30 * time.Minute
 	updateTableTimeout                         = 20 * time.Minute
@@ -77,6 +81,48 @@ func waitTableActive(ctx context.Context, conn *dynamodb.DynamoDB, tableName str
 	return nil, err
 }
 
+func waitImportComplete(ctx context.Context, conn *dynamodb.DynamoDB, importARN string, timeout time.Duration) (*dynamodb.ImportTableDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{dynamodb.ImportStatusInProgress, dynamodb.ImportStatusCancelling},
+		Target:  []string{dynamodb.ImportStatusCompleted},
+		Timeout: maxDuration(importTableTimeout, timeout),
+		Refresh: statusImport(ctx, conn, importARN),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dynamodb.ImportTableDescription); ok {
+		if status := aws.StringValue(output.ImportStatus); status == dynamodb.ImportStatusFailed || status == dynamodb.ImportStatusCancelled {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.FailureCode), aws.StringValue(output.FailureMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTableExportCompleted(ctx context.Context, conn *dynamodb.DynamoDB, arn string, timeout time.Duration) (*dynamodb.ExportDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{dynamodb.ExportStatusInProgress},
+		Target:  []string{dynamodb.ExportStatusCompleted},
+		Timeout: maxDuration(exportTableTimeout, timeout),
+		Refresh: statusTableExport(ctx, conn, arn),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dynamodb.ExportDescription); ok {
+		if aws.StringValue(output.ExportStatus) == dynamodb.ExportStatusFailed {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.FailureCode), aws.StringValue(output.FailureMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitTableDeleted(ctx context.Context, conn *dynamodb.DynamoDB, tableName string, timeout time.Duration) (*dynamodb.TableDescription, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{dynamodb.TableStatusActive, dynamodb.TableStatusDeleting},
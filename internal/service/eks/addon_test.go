@@ -9,6 +9,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -396,6 +397,42 @@ func TestAccEKSAddon_tags(t *testing.T) {
 	})
 }
 
+func TestAccEKSAddon_podIdentityAgent(t *testing.T) {
+	ctx := acctest.Context(t)
+	var addon eks.Addon
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	addonResourceName := "aws_eks_addon.test"
+	addonName := "eks-pod-identity-agent"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+			testAccPreCheckAddon(ctx, t)
+			testAccPreCheckAddonAvailable(ctx, t, addonName)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, eks.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAddonDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddonConfig_basic(rName, addonName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAddonExists(ctx, addonResourceName, &addon),
+					resource.TestCheckResourceAttr(addonResourceName, "addon_name", addonName),
+					resource.TestCheckResourceAttrSet(addonResourceName, "addon_version"),
+					resource.TestCheckResourceAttr(addonResourceName, "service_account_role_arn", ""),
+				),
+			},
+			{
+				ResourceName:      addonResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAddonExists(ctx context.Context, n string, v *eks.Addon) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -475,6 +512,29 @@ func testAccPreCheckAddon(ctx context.Context, t *testing.T) {
 	}
 }
 
+// testAccPreCheckAddonAvailable skips the test if the named add-on is not offered in the current Region.
+func testAccPreCheckAddonAvailable(ctx context.Context, t *testing.T, addonName string) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EKSConn(ctx)
+
+	input := &eks.DescribeAddonVersionsInput{
+		AddonName: aws.String(addonName),
+	}
+
+	output, err := conn.DescribeAddonVersionsWithContext(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+
+	if output == nil || len(output.Addons) == 0 {
+		t.Skipf("skipping acceptance testing: EKS add-on %s is not available", addonName)
+	}
+}
+
 func testAccAddonConfig_base(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 data "aws_partition" "current" {}
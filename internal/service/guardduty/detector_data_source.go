@@ -20,6 +20,38 @@ func DataSourceDetector() *schema.Resource {
 		ReadWithoutTimeout: dataSourceDetectorRead,
 
 		Schema: map[string]*schema.Schema{
+			"features": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"additional_configuration": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -79,6 +111,9 @@ func dataSourceDetectorRead(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	d.SetId(detectorId)
+	if err := d.Set("features", flattenDetectorFeatureConfigurationResults(getResp.Features)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting features: %s", err)
+	}
 	d.Set("status", getResp.Status)
 	d.Set("service_role_arn", getResp.ServiceRole)
 	d.Set("finding_publishing_frequency", getResp.FindingPublishingFrequency)
@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package guardduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_guardduty_detector_feature", name="Detector Feature")
+func ResourceDetectorFeature() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDetectorFeaturePut,
+		ReadWithoutTimeout:   resourceDetectorFeatureRead,
+		UpdateWithoutTimeout: resourceDetectorFeaturePut,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"additional_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(guardduty.FeatureAdditionalConfiguration_Values(), false),
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(guardduty.FeatureStatus_Values(), false),
+						},
+					},
+				},
+			},
+			"detector_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(guardduty.DetectorFeature_Values(), false),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(guardduty.FeatureStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceDetectorFeaturePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GuardDutyConn(ctx)
+
+	detectorID, name := d.Get("detector_id").(string), d.Get("name").(string)
+	feature := &guardduty.DetectorFeatureConfiguration{
+		Name:   aws.String(name),
+		Status: aws.String(d.Get("status").(string)),
+	}
+
+	if v, ok := d.GetOk("additional_configuration"); ok && len(v.([]interface{})) > 0 {
+		feature.AdditionalConfiguration = expandDetectorAdditionalConfigurations(v.([]interface{}))
+	}
+
+	input := &guardduty.UpdateDetectorInput{
+		DetectorId: aws.String(detectorID),
+		Features:   []*guardduty.DetectorFeatureConfiguration{feature},
+	}
+
+	_, err := conn.UpdateDetectorWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating GuardDuty Detector (%s) Feature (%s): %s", detectorID, name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(DetectorFeatureCreateResourceID(detectorID, name))
+	}
+
+	return append(diags, resourceDetectorFeatureRead(ctx, d, meta)...)
+}
+
+func resourceDetectorFeatureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GuardDutyConn(ctx)
+
+	detectorID, name, err := DetectorFeatureParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	feature, err := FindDetectorFeatureByTwoPartKey(ctx, conn, detectorID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GuardDuty Detector Feature (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GuardDuty Detector Feature (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("additional_configuration", flattenDetectorAdditionalConfigurationResults(feature.AdditionalConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting additional_configuration: %s", err)
+	}
+	d.Set("detector_id", detectorID)
+	d.Set("name", feature.Name)
+	d.Set("status", feature.Status)
+
+	return diags
+}
+
+const detectorFeatureResourceIDSeparator = ","
+
+func DetectorFeatureCreateResourceID(detectorID, name string) string {
+	parts := []string{detectorID, name}
+	id := strings.Join(parts, detectorFeatureResourceIDSeparator)
+
+	return id
+}
+
+func DetectorFeatureParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, detectorFeatureResourceIDSeparator)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected detector-id%[2]sfeature-name", id, detectorFeatureResourceIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func FindDetectorFeatureByTwoPartKey(ctx context.Context, conn *guardduty.GuardDuty, detectorID, name string) (*guardduty.DetectorFeatureConfigurationResult, error) {
+	output, err := FindDetectorByID(ctx, conn, detectorID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output.Features {
+		if v != nil && aws.StringValue(v.Name) == name {
+			return v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{}
+}
+
+func expandDetectorAdditionalConfigurations(tfList []interface{}) []*guardduty.DetectorAdditionalConfiguration {
+	var apiObjects []*guardduty.DetectorAdditionalConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &guardduty.DetectorAdditionalConfiguration{}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["status"].(string); ok && v != "" {
+			apiObject.Status = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenDetectorAdditionalConfigurationResults(apiObjects []*guardduty.DetectorAdditionalConfigurationResult) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":   aws.StringValue(apiObject.Name),
+			"status": aws.StringValue(apiObject.Status),
+		})
+	}
+
+	return tfList
+}
+
+func flattenDetectorFeatureConfigurationResults(apiObjects []*guardduty.DetectorFeatureConfigurationResult) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"additional_configuration": flattenDetectorAdditionalConfigurationResults(apiObject.AdditionalConfiguration),
+			"name":                     aws.StringValue(apiObject.Name),
+			"status":                   aws.StringValue(apiObject.Status),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package guardduty_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfguardduty "github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
+)
+
+func testAccDetectorFeature_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_guardduty_detector_feature.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// GuardDuty Detector Features cannot be deleted separately.
+		// Ensure parent resource is destroyed instead.
+		CheckDestroy: testAccCheckDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorFeatureConfig_basic("RDS_LOGIN_EVENTS", "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorFeatureExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "detector_id", "aws_guardduty_detector.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "RDS_LOGIN_EVENTS"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDetectorFeatureConfig_basic("RDS_LOGIN_EVENTS", "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorFeatureExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDetectorFeature_additionalConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_guardduty_detector_feature.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorFeatureConfig_additionalConfiguration("ENABLED", "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorFeatureExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "EKS_RUNTIME_MONITORING"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.name", "EKS_ADDON_MANAGEMENT"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.status", "DISABLED"),
+				),
+			},
+			{
+				Config: testAccDetectorFeatureConfig_additionalConfiguration("ENABLED", "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorFeatureExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.status", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDetectorFeature_multiple(t *testing.T) {
+	ctx := acctest.Context(t)
+	resource1Name := "aws_guardduty_detector_feature.test1"
+	resource2Name := "aws_guardduty_detector_feature.test2"
+	dataSourceName := "data.aws_guardduty_detector.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorFeatureConfig_multiple("ENABLED", "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorFeatureExists(ctx, resource1Name),
+					testAccCheckDetectorFeatureExists(ctx, resource2Name),
+					resource.TestCheckResourceAttr(resource1Name, "status", "ENABLED"),
+					resource.TestCheckResourceAttr(resource2Name, "status", "DISABLED"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "features.*", map[string]string{
+						"name":   "S3_DATA_EVENTS",
+						"status": "ENABLED",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "features.*", map[string]string{
+						"name":   "LAMBDA_NETWORK_LOGS",
+						"status": "DISABLED",
+					}),
+				),
+			},
+			{
+				Config: testAccDetectorFeatureConfig_multiple("DISABLED", "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorFeatureExists(ctx, resource1Name),
+					testAccCheckDetectorFeatureExists(ctx, resource2Name),
+					resource.TestCheckResourceAttr(resource1Name, "status", "DISABLED"),
+					resource.TestCheckResourceAttr(resource2Name, "status", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDetectorFeatureExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		detectorID, name, err := tfguardduty.DetectorFeatureParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GuardDutyConn(ctx)
+
+		_, err = tfguardduty.FindDetectorFeatureByTwoPartKey(ctx, conn, detectorID, name)
+
+		return err
+	}
+}
+
+func testAccDetectorFeatureConfig_basic(name, status string) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {
+  enable = true
+}
+
+resource "aws_guardduty_detector_feature" "test" {
+  detector_id = aws_guardduty_detector.test.id
+  name        = %[1]q
+  status      = %[2]q
+}
+`, name, status)
+}
+
+func testAccDetectorFeatureConfig_additionalConfiguration(status, additionalStatus string) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {
+  enable = true
+}
+
+resource "aws_guardduty_detector_feature" "test" {
+  detector_id = aws_guardduty_detector.test.id
+  name        = "EKS_RUNTIME_MONITORING"
+  status      = %[1]q
+
+  additional_configuration {
+    name   = "EKS_ADDON_MANAGEMENT"
+    status = %[2]q
+  }
+}
+`, status, additionalStatus)
+}
+
+func testAccDetectorFeatureConfig_multiple(status1, status2 string) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {
+  enable = true
+}
+
+resource "aws_guardduty_detector_feature" "test1" {
+  detector_id = aws_guardduty_detector.test.id
+  name        = "S3_DATA_EVENTS"
+  status      = %[1]q
+}
+
+resource "aws_guardduty_detector_feature" "test2" {
+  detector_id = aws_guardduty_detector.test.id
+  name        = "LAMBDA_NETWORK_LOGS"
+  status      = %[2]q
+}
+
+data "aws_guardduty_detector" "test" {
+  id = aws_guardduty_detector.test.id
+
+  depends_on = [
+    aws_guardduty_detector_feature.test1,
+    aws_guardduty_detector_feature.test2,
+  ]
+}
+`, status1, status2)
+}
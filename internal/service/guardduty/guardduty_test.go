@@ -27,6 +27,11 @@ func TestAccGuardDuty_serial(t *testing.T) {
 			"datasource_basic":                  testAccDetectorDataSource_basic,
 			"datasource_id":                     testAccDetectorDataSource_ID,
 		},
+		"DetectorFeature": {
+			"basic":                   testAccDetectorFeature_basic,
+			"additionalConfiguration": testAccDetectorFeature_additionalConfiguration,
+			"multiple":                testAccDetectorFeature_multiple,
+		},
 		"Filter": {
 			"basic":      testAccFilter_basic,
 			"update":     testAccFilter_update,
@@ -50,6 +55,10 @@ func TestAccGuardDuty_serial(t *testing.T) {
 			"kubernetes":                    testAccOrganizationConfiguration_kubernetes,
 			"malwareProtection":             testAccOrganizationConfiguration_malwareprotection,
 		},
+		"OrganizationConfigurationFeature": {
+			"basic":                   testAccOrganizationConfigurationFeature_basic,
+			"additionalConfiguration": testAccOrganizationConfigurationFeature_additionalConfiguration,
+		},
 		"ThreatIntelSet": {
 			"basic": testAccThreatIntelSet_basic,
 			"tags":  testAccThreatIntelSet_tags,
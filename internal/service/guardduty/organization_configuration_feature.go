@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package guardduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_guardduty_organization_configuration_feature", name="Organization Configuration Feature")
+func ResourceOrganizationConfigurationFeature() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOrganizationConfigurationFeaturePut,
+		ReadWithoutTimeout:   resourceOrganizationConfigurationFeatureRead,
+		UpdateWithoutTimeout: resourceOrganizationConfigurationFeaturePut,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"additional_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_enable": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(guardduty.OrgFeatureStatus_Values(), false),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(guardduty.OrgFeatureAdditionalConfiguration_Values(), false),
+						},
+					},
+				},
+			},
+			"auto_enable": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(guardduty.OrgFeatureStatus_Values(), false),
+			},
+			"detector_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(guardduty.OrgFeature_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceOrganizationConfigurationFeaturePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GuardDutyConn(ctx)
+
+	detectorID, name := d.Get("detector_id").(string), d.Get("name").(string)
+
+	// UpdateOrganizationConfiguration requires the organization-wide member
+	// auto-enablement setting, so carry the current value through unchanged.
+	output, err := FindOrganizationConfigurationByID(ctx, conn, detectorID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GuardDuty Organization Configuration (%s): %s", detectorID, err)
+	}
+
+	feature := &guardduty.OrganizationFeatureConfiguration{
+		AutoEnable: aws.String(d.Get("auto_enable").(string)),
+		Name:       aws.String(name),
+	}
+
+	if v, ok := d.GetOk("additional_configuration"); ok && len(v.([]interface{})) > 0 {
+		feature.AdditionalConfiguration = expandOrganizationAdditionalConfigurations(v.([]interface{}))
+	}
+
+	input := &guardduty.UpdateOrganizationConfigurationInput{
+		AutoEnableOrganizationMembers: output.AutoEnableOrganizationMembers,
+		DetectorId:                    aws.String(detectorID),
+		Features:                      []*guardduty.OrganizationFeatureConfiguration{feature},
+	}
+
+	_, err = conn.UpdateOrganizationConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating GuardDuty Organization Configuration (%s) Feature (%s): %s", detectorID, name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(OrganizationConfigurationFeatureCreateResourceID(detectorID, name))
+	}
+
+	return append(diags, resourceOrganizationConfigurationFeatureRead(ctx, d, meta)...)
+}
+
+func resourceOrganizationConfigurationFeatureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GuardDutyConn(ctx)
+
+	detectorID, name, err := OrganizationConfigurationFeatureParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	feature, err := FindOrganizationConfigurationFeatureByTwoPartKey(ctx, conn, detectorID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GuardDuty Organization Configuration Feature (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GuardDuty Organization Configuration Feature (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("additional_configuration", flattenOrganizationAdditionalConfigurationResults(feature.AdditionalConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting additional_configuration: %s", err)
+	}
+	d.Set("auto_enable", feature.AutoEnable)
+	d.Set("detector_id", detectorID)
+	d.Set("name", feature.Name)
+
+	return diags
+}
+
+const organizationConfigurationFeatureResourceIDSeparator = ","
+
+func OrganizationConfigurationFeatureCreateResourceID(detectorID, name string) string {
+	parts := []string{detectorID, name}
+	id := strings.Join(parts, organizationConfigurationFeatureResourceIDSeparator)
+
+	return id
+}
+
+func OrganizationConfigurationFeatureParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, organizationConfigurationFeatureResourceIDSeparator)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected detector-id%[2]sfeature-name", id, organizationConfigurationFeatureResourceIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func FindOrganizationConfigurationFeatureByTwoPartKey(ctx context.Context, conn *guardduty.GuardDuty, detectorID, name string) (*guardduty.OrganizationFeatureConfigurationResult, error) {
+	output, err := FindOrganizationConfigurationByID(ctx, conn, detectorID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output.Features {
+		if v != nil && aws.StringValue(v.Name) == name {
+			return v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{}
+}
+
+func expandOrganizationAdditionalConfigurations(tfList []interface{}) []*guardduty.OrganizationAdditionalConfiguration {
+	var apiObjects []*guardduty.OrganizationAdditionalConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &guardduty.OrganizationAdditionalConfiguration{}
+
+		if v, ok := tfMap["auto_enable"].(string); ok && v != "" {
+			apiObject.AutoEnable = aws.String(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenOrganizationAdditionalConfigurationResults(apiObjects []*guardduty.OrganizationAdditionalConfigurationResult) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"auto_enable": aws.StringValue(apiObject.AutoEnable),
+			"name":        aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
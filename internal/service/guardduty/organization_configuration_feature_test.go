@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package guardduty_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfguardduty "github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
+)
+
+func testAccOrganizationConfigurationFeature_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_guardduty_organization_configuration_feature.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationsAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// GuardDuty Organization Configuration Features cannot be deleted separately.
+		// Ensure parent resource is destroyed instead.
+		CheckDestroy: testAccCheckDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationFeatureConfig_basic("RDS_LOGIN_EVENTS", "NEW"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationConfigurationFeatureExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "NEW"),
+					resource.TestCheckResourceAttrPair(resourceName, "detector_id", "aws_guardduty_detector.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "RDS_LOGIN_EVENTS"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOrganizationConfigurationFeatureConfig_basic("RDS_LOGIN_EVENTS", "NONE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationConfigurationFeatureExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "NONE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationConfigurationFeature_additionalConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_guardduty_organization_configuration_feature.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationsAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationFeatureConfig_additionalConfiguration("NEW", "NONE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationConfigurationFeatureExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "NEW"),
+					resource.TestCheckResourceAttr(resourceName, "name", "EKS_RUNTIME_MONITORING"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.auto_enable", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.name", "EKS_ADDON_MANAGEMENT"),
+				),
+			},
+			{
+				Config: testAccOrganizationConfigurationFeatureConfig_additionalConfiguration("ALL", "ALL"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationConfigurationFeatureExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "ALL"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.auto_enable", "ALL"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrganizationConfigurationFeatureExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		detectorID, name, err := tfguardduty.OrganizationConfigurationFeatureParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GuardDutyConn(ctx)
+
+		_, err = tfguardduty.FindOrganizationConfigurationFeatureByTwoPartKey(ctx, conn, detectorID, name)
+
+		return err
+	}
+}
+
+func testAccOrganizationConfigurationFeatureConfig_basic(name, autoEnable string) string {
+	return acctest.ConfigCompose(
+		testAccOrganizationConfigurationConfigBase,
+		fmt.Sprintf(`
+resource "aws_guardduty_organization_configuration" "test" {
+  depends_on = [aws_guardduty_organization_admin_account.test]
+
+  auto_enable_organization_members = "ALL"
+  detector_id                      = aws_guardduty_detector.test.id
+}
+
+resource "aws_guardduty_organization_configuration_feature" "test" {
+  depends_on = [aws_guardduty_organization_configuration.test]
+
+  detector_id = aws_guardduty_detector.test.id
+  name        = %[1]q
+  auto_enable = %[2]q
+}
+`, name, autoEnable))
+}
+
+func testAccOrganizationConfigurationFeatureConfig_additionalConfiguration(autoEnable, additionalAutoEnable string) string {
+	return acctest.ConfigCompose(
+		testAccOrganizationConfigurationConfigBase,
+		fmt.Sprintf(`
+resource "aws_guardduty_organization_configuration" "test" {
+  depends_on = [aws_guardduty_organization_admin_account.test]
+
+  auto_enable_organization_members = "ALL"
+  detector_id                      = aws_guardduty_detector.test.id
+}
+
+resource "aws_guardduty_organization_configuration_feature" "test" {
+  depends_on = [aws_guardduty_organization_configuration.test]
+
+  detector_id = aws_guardduty_detector.test.id
+  name        = "EKS_RUNTIME_MONITORING"
+  auto_enable = %[1]q
+
+  additional_configuration {
+    name        = "EKS_ADDON_MANAGEMENT"
+    auto_enable = %[2]q
+  }
+}
+`, autoEnable, additionalAutoEnable))
+}
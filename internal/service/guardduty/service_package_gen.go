@@ -47,6 +47,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceDetectorFeature,
+			TypeName: "aws_guardduty_detector_feature",
+			Name:     "Detector Feature",
+		},
 		{
 			Factory:  ResourceFilter,
 			TypeName: "aws_guardduty_filter",
@@ -79,6 +84,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceOrganizationConfiguration,
 			TypeName: "aws_guardduty_organization_configuration",
 		},
+		{
+			Factory:  ResourceOrganizationConfigurationFeature,
+			TypeName: "aws_guardduty_organization_configuration_feature",
+			Name:     "Organization Configuration Feature",
+		},
 		{
 			Factory:  ResourcePublishingDestination,
 			TypeName: "aws_guardduty_publishing_destination",
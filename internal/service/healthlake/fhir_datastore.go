@@ -0,0 +1,484 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="FHIR Datastore")
+// @Tags(identifierAttribute="arn")
+func newResourceFHIRDatastore(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceFHIRDatastore{}
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type resourceFHIRDatastore struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceFHIRDatastore) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_healthlake_fhir_datastore"
+}
+
+func (r *resourceFHIRDatastore) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"datastore_endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"datastore_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"datastore_status": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"datastore_type_version": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.FHIRVersion](),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID:      framework.IDAttribute(),
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"identity_provider_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[identityProviderConfigurationData](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"authorization_strategy": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								enum.FrameworkValidate[awstypes.AuthorizationStrategy](),
+							},
+						},
+						"fine_grained_authorization_enabled": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"idp_lambda_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+						"metadata": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"preload_data_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[preloadDataConfigData](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"preload_data_type": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								enum.FrameworkValidate[awstypes.PreloadDataType](),
+							},
+						},
+					},
+				},
+			},
+			"sse_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[sseConfigurationData](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"kms_encryption_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[kmsEncryptionConfigData](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"cmk_type": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											enum.FrameworkValidate[awstypes.CmkType](),
+										},
+									},
+									"kms_key_id": schema.StringAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceFHIRDatastore) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceFHIRDatastoreData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthLakeClient(ctx)
+
+	input := &healthlake.CreateFHIRDatastoreInput{}
+
+	response.Diagnostics.Append(flex.Expand(ctx, &data, input)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateFHIRDatastore(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating HealthLake FHIR Datastore", err.Error())
+
+		return
+	}
+
+	data.DatastoreId = flex.StringToFramework(ctx, output.DatastoreId)
+
+	datastore, err := waitFHIRDatastoreCreated(ctx, conn, data.DatastoreId.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for HealthLake FHIR Datastore (%s) create", data.DatastoreId.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, datastore)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceFHIRDatastore) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceFHIRDatastoreData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthLakeClient(ctx)
+
+	datastore, err := findFHIRDatastoreByID(ctx, conn, data.DatastoreId.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading HealthLake FHIR Datastore (%s)", data.DatastoreId.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, datastore)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceFHIRDatastore) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new resourceFHIRDatastoreData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Tags only.
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceFHIRDatastore) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceFHIRDatastoreData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthLakeClient(ctx)
+
+	_, err := conn.DeleteFHIRDatastore(ctx, &healthlake.DeleteFHIRDatastoreInput{
+		DatastoreId: flex.StringFromFramework(ctx, data.DatastoreId),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting HealthLake FHIR Datastore (%s)", data.DatastoreId.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitFHIRDatastoreDeleted(ctx, conn, data.DatastoreId.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for HealthLake FHIR Datastore (%s) delete", data.DatastoreId.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceFHIRDatastore) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type resourceFHIRDatastoreData struct {
+	DatastoreArn                  types.String                                                       `tfsdk:"arn"`
+	DatastoreEndpoint             types.String                                                       `tfsdk:"datastore_endpoint"`
+	DatastoreId                   types.String                                                       `tfsdk:"id"`
+	DatastoreName                 types.String                                                       `tfsdk:"datastore_name"`
+	DatastoreStatus               types.String                                                       `tfsdk:"datastore_status"`
+	DatastoreTypeVersion          types.String                                                       `tfsdk:"datastore_type_version"`
+	IdentityProviderConfiguration fwtypes.ListNestedObjectValueOf[identityProviderConfigurationData] `tfsdk:"identity_provider_configuration"`
+	PreloadDataConfig             fwtypes.ListNestedObjectValueOf[preloadDataConfigData]             `tfsdk:"preload_data_config"`
+	SseConfiguration              fwtypes.ListNestedObjectValueOf[sseConfigurationData]              `tfsdk:"sse_configuration"`
+	Tags                          types.Map                                                          `tfsdk:"tags"`
+	TagsAll                       types.Map                                                          `tfsdk:"tags_all"`
+	Timeouts                      timeouts.Value                                                     `tfsdk:"timeouts"`
+}
+
+type identityProviderConfigurationData struct {
+	AuthorizationStrategy           types.String `tfsdk:"authorization_strategy"`
+	FineGrainedAuthorizationEnabled types.Bool   `tfsdk:"fine_grained_authorization_enabled"`
+	IdpLambdaArn                    fwtypes.ARN  `tfsdk:"idp_lambda_arn"`
+	Metadata                        types.String `tfsdk:"metadata"`
+}
+
+type preloadDataConfigData struct {
+	PreloadDataType types.String `tfsdk:"preload_data_type"`
+}
+
+type sseConfigurationData struct {
+	KmsEncryptionConfig fwtypes.ListNestedObjectValueOf[kmsEncryptionConfigData] `tfsdk:"kms_encryption_config"`
+}
+
+type kmsEncryptionConfigData struct {
+	CmkType  types.String `tfsdk:"cmk_type"`
+	KmsKeyId types.String `tfsdk:"kms_key_id"`
+}
+
+func (data *resourceFHIRDatastoreData) refreshFromOutput(ctx context.Context, output *awstypes.DatastoreProperties) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if output == nil {
+		return diags
+	}
+
+	// Preserve configured values that are not returned by the API.
+	preloadDataConfig := data.PreloadDataConfig
+	sseConfiguration := data.SseConfiguration
+	identityProviderConfiguration := data.IdentityProviderConfiguration
+
+	diags.Append(flex.Flatten(ctx, output, data)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if output.PreloadDataConfig == nil {
+		data.PreloadDataConfig = preloadDataConfig
+	}
+
+	// Default encryption and authorization settings are only reported if they were explicitly configured.
+	if v := output.SseConfiguration; v != nil && v.KmsEncryptionConfig != nil && v.KmsEncryptionConfig.CmkType == awstypes.CmkTypeAoCmk && len(sseConfiguration.Elements()) == 0 {
+		data.SseConfiguration = sseConfiguration
+	}
+
+	if v := output.IdentityProviderConfiguration; v != nil && v.AuthorizationStrategy == awstypes.AuthorizationStrategyAwsAuth && len(identityProviderConfiguration.Elements()) == 0 {
+		data.IdentityProviderConfiguration = identityProviderConfiguration
+	}
+
+	return diags
+}
+
+func findFHIRDatastoreByID(ctx context.Context, conn *healthlake.Client, id string) (*awstypes.DatastoreProperties, error) {
+	input := &healthlake.DescribeFHIRDatastoreInput{
+		DatastoreId: aws.String(id),
+	}
+
+	output, err := conn.DescribeFHIRDatastore(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DatastoreProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.DatastoreProperties.DatastoreStatus; status == awstypes.DatastoreStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output.DatastoreProperties, nil
+}
+
+func statusFHIRDatastore(ctx context.Context, conn *healthlake.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFHIRDatastoreByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.DatastoreStatus), nil
+	}
+}
+
+func waitFHIRDatastoreCreated(ctx context.Context, conn *healthlake.Client, id string, timeout time.Duration) (*awstypes.DatastoreProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DatastoreStatusCreating),
+		Target:     enum.Slice(awstypes.DatastoreStatusActive),
+		Refresh:    statusFHIRDatastore(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStatus(ctx, stateConf)
+
+	if output, ok := outputRaw.(*awstypes.DatastoreProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFHIRDatastoreDeleted(ctx context.Context, conn *healthlake.Client, id string, timeout time.Duration) (*awstypes.DatastoreProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DatastoreStatusActive, awstypes.DatastoreStatusDeleting),
+		Target:     []string{},
+		Refresh:    statusFHIRDatastore(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStatus(ctx, stateConf)
+
+	if output, ok := outputRaw.(*awstypes.DatastoreProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="FHIR Datastore")
+func newDataSourceFHIRDatastore(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceFHIRDatastore{}, nil
+}
+
+type dataSourceFHIRDatastore struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceFHIRDatastore) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_healthlake_fhir_datastore"
+}
+
+func (d *dataSourceFHIRDatastore) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			"datastore_endpoint": schema.StringAttribute{
+				Computed: true,
+			},
+			"datastore_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"datastore_status": schema.StringAttribute{
+				Computed: true,
+			},
+			"datastore_type_version": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Required: true,
+			},
+			"identity_provider_configuration": schema.ListNestedAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[identityProviderConfigurationData](ctx),
+				Computed:   true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"authorization_strategy": schema.StringAttribute{
+							Computed: true,
+						},
+						"fine_grained_authorization_enabled": schema.BoolAttribute{
+							Computed: true,
+						},
+						"idp_lambda_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Computed:   true,
+						},
+						"metadata": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"preload_data_config": schema.ListNestedAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[preloadDataConfigData](ctx),
+				Computed:   true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"preload_data_type": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"sse_configuration": schema.ListNestedAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[sseConfigurationData](ctx),
+				Computed:   true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_encryption_config": schema.ListNestedAttribute{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[kmsEncryptionConfigData](ctx),
+							Computed:   true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"cmk_type": schema.StringAttribute{
+										Computed: true,
+									},
+									"kms_key_id": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *dataSourceFHIRDatastore) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceFHIRDatastoreData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().HealthLakeClient(ctx)
+
+	output, err := findFHIRDatastoreByID(ctx, conn, data.DatastoreId.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading HealthLake FHIR Datastore (%s)", data.DatastoreId.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, output, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tags, err := listTags(ctx, conn, aws.ToString(output.DatastoreArn))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing tags for HealthLake FHIR Datastore (%s)", data.DatastoreId.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Tags = flex.FlattenFrameworkStringValueMapLegacy(ctx, tags.IgnoreAWS().IgnoreConfig(d.Meta().IgnoreTagsConfig).Map())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceFHIRDatastoreData struct {
+	DatastoreArn                  types.String                                                       `tfsdk:"arn"`
+	DatastoreEndpoint             types.String                                                       `tfsdk:"datastore_endpoint"`
+	DatastoreId                   types.String                                                       `tfsdk:"id"`
+	DatastoreName                 types.String                                                       `tfsdk:"datastore_name"`
+	DatastoreStatus               types.String                                                       `tfsdk:"datastore_status"`
+	DatastoreTypeVersion          types.String                                                       `tfsdk:"datastore_type_version"`
+	IdentityProviderConfiguration fwtypes.ListNestedObjectValueOf[identityProviderConfigurationData] `tfsdk:"identity_provider_configuration"`
+	PreloadDataConfig             fwtypes.ListNestedObjectValueOf[preloadDataConfigData]             `tfsdk:"preload_data_config"`
+	SseConfiguration              fwtypes.ListNestedObjectValueOf[sseConfigurationData]              `tfsdk:"sse_configuration"`
+	Tags                          types.Map                                                          `tfsdk:"tags"`
+}
@@ -0,0 +1,352 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfhealthlake "github.com/hashicorp/terraform-provider-aws/internal/service/healthlake"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccHealthLakeFHIRDatastore_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var datastore awstypes.DatastoreProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_healthlake_fhir_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.HealthLake)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.HealthLake),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFHIRDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFHIRDatastoreConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(ctx, resourceName, &datastore),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "healthlake", regexp.MustCompile(`datastore/fhir/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "datastore_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "datastore_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "datastore_status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "datastore_type_version", "R4"),
+					resource.TestCheckResourceAttr(resourceName, "identity_provider_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "preload_data_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "sse_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccHealthLakeFHIRDatastore_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var datastore awstypes.DatastoreProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_healthlake_fhir_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.HealthLake)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.HealthLake),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFHIRDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFHIRDatastoreConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(ctx, resourceName, &datastore),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfhealthlake.ResourceFHIRDatastore, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccHealthLakeFHIRDatastore_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var datastore awstypes.DatastoreProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_healthlake_fhir_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.HealthLake)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.HealthLake),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFHIRDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFHIRDatastoreConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(ctx, resourceName, &datastore),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccFHIRDatastoreConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(ctx, resourceName, &datastore),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFHIRDatastoreConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(ctx, resourceName, &datastore),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHealthLakeFHIRDatastore_sseCustomerManagedKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	var datastore awstypes.DatastoreProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_healthlake_fhir_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.HealthLake)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.HealthLake),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFHIRDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFHIRDatastoreConfig_sseCustomerManagedKey(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(ctx, resourceName, &datastore),
+					resource.TestCheckResourceAttr(resourceName, "preload_data_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "preload_data_config.0.preload_data_type", "SYNTHEA"),
+					resource.TestCheckResourceAttr(resourceName, "sse_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sse_configuration.0.kms_encryption_config.0.cmk_type", "CUSTOMER_MANAGED_KMS_KEY"),
+					resource.TestCheckResourceAttrPair(resourceName, "sse_configuration.0.kms_encryption_config.0.kms_key_id", "aws_kms_key.test", "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"preload_data_config"},
+			},
+		},
+	})
+}
+
+func TestAccHealthLakeFHIRDatastore_identityProvider(t *testing.T) {
+	ctx := acctest.Context(t)
+	var datastore awstypes.DatastoreProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_healthlake_fhir_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.HealthLake)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.HealthLake),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFHIRDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFHIRDatastoreConfig_identityProvider(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(ctx, resourceName, &datastore),
+					resource.TestCheckResourceAttr(resourceName, "identity_provider_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "identity_provider_configuration.0.authorization_strategy", "SMART_ON_FHIR_V1"),
+					resource.TestCheckResourceAttr(resourceName, "identity_provider_configuration.0.fine_grained_authorization_enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "identity_provider_configuration.0.idp_lambda_arn", "aws_lambda_function.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFHIRDatastoreDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).HealthLakeClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_healthlake_fhir_datastore" {
+				continue
+			}
+
+			_, err := tfhealthlake.FindFHIRDatastoreByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("HealthLake FHIR Datastore %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFHIRDatastoreExists(ctx context.Context, n string, v *awstypes.DatastoreProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).HealthLakeClient(ctx)
+
+		output, err := tfhealthlake.FindFHIRDatastoreByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccFHIRDatastoreConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_healthlake_fhir_datastore" "test" {
+  datastore_name         = %[1]q
+  datastore_type_version = "R4"
+}
+`, rName)
+}
+
+func testAccFHIRDatastoreConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_healthlake_fhir_datastore" "test" {
+  datastore_name         = %[1]q
+  datastore_type_version = "R4"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccFHIRDatastoreConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_healthlake_fhir_datastore" "test" {
+  datastore_name         = %[1]q
+  datastore_type_version = "R4"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccFHIRDatastoreConfig_sseCustomerManagedKey(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_healthlake_fhir_datastore" "test" {
+  datastore_name         = %[1]q
+  datastore_type_version = "R4"
+
+  preload_data_config {
+    preload_data_type = "SYNTHEA"
+  }
+
+  sse_configuration {
+    kms_encryption_config {
+      cmk_type   = "CUSTOMER_MANAGED_KMS_KEY"
+      kms_key_id = aws_kms_key.test.arn
+    }
+  }
+}
+`, rName)
+}
+
+func testAccFHIRDatastoreConfig_identityProvider(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigLambdaBase(rName, rName, rName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs16.x"
+}
+
+resource "aws_healthlake_fhir_datastore" "test" {
+  datastore_name         = %[1]q
+  datastore_type_version = "R4"
+
+  identity_provider_configuration {
+    authorization_strategy             = "SMART_ON_FHIR_V1"
+    fine_grained_authorization_enabled = true
+    idp_lambda_arn                     = aws_lambda_function.test.arn
+
+    metadata = jsonencode({
+      issuer                                = "https://example.com"
+      authorization_endpoint                = "https://example.com/authorize"
+      token_endpoint                        = "https://example.com/token"
+      jwks_uri                              = "https://example.com/jwks"
+      response_types_supported              = ["code", "token"]
+      response_modes_supported              = ["query", "fragment", "form_post"]
+      grant_types_supported                 = ["authorization_code", "implicit", "refresh_token"]
+      subject_types_supported               = ["public"]
+      scopes_supported                      = ["openid", "profile", "email", "phone", "launch/patient", "system/*.*", "patient/*.read"]
+      token_endpoint_auth_methods_supported = ["client_secret_basic"]
+      claims_supported                      = ["ver", "jti", "iss", "aud", "iat", "exp", "cid", "uid", "scp", "sub"]
+      code_challenge_methods_supported      = ["S256"]
+      registration_endpoint                 = "https://example.com/register"
+      management_endpoint                   = "https://example.com/manage"
+      introspection_endpoint                = "https://example.com/introspect"
+      revocation_endpoint                   = "https://example.com/revoke"
+      capabilities                          = ["launch-ehr", "sso-openid-connect", "client-public"]
+    })
+  }
+}
+`, rName))
+}
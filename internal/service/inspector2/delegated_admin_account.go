@@ -205,28 +205,25 @@ func statusDelegatedAdminAccount(ctx context.Context, conn *inspector2.Client, a
 }
 
 func FindDelegatedAdminAccountStatusID(ctx context.Context, conn *inspector2.Client, accountID string) (string, string, error) {
-	pages := inspector2.NewListDelegatedAdminAccountsPaginator(conn, &inspector2.ListDelegatedAdminAccountsInput{})
-
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
-
-		if err != nil {
-			var ve types.ValidationException
-			if errs.AsContains(err, &ve, "is the delegated admin") {
-				return string(types.RelationshipStatusEnabled), accountID, nil
-			}
+	account, err := findDelegatedAdminAccount(ctx, conn, &inspector2.ListDelegatedAdminAccountsInput{}, func(v types.DelegatedAdminAccount) bool {
+		return aws.ToString(v.AccountId) == accountID
+	})
 
-			return "", "", err
-		}
+	var ve types.ValidationException
+	if errs.AsContains(err, &ve, "is the delegated admin") {
+		return string(types.RelationshipStatusEnabled), accountID, nil
+	}
 
-		for _, account := range page.DelegatedAdminAccounts {
-			if aws.ToString(account.AccountId) == accountID {
-				return string(account.Status), aws.ToString(account.AccountId), nil
-			}
+	if tfresource.NotFound(err) {
+		return "", "", &retry.NotFoundError{
+			LastError: err,
+			Message:   fmt.Sprintf("delegated admin account not found for %s", accountID),
 		}
 	}
 
-	return "", "", &retry.NotFoundError{
-		Message: fmt.Sprintf("delegated admin account not found for %s", accountID),
+	if err != nil {
+		return "", "", err
 	}
+
+	return string(account.Status), aws.ToString(account.AccountId), nil
 }
@@ -0,0 +1,72 @@
+// Code generated by "internal/generate/listpages/main.go -AWSSDKVersion=2 -ListOps=ListDelegatedAdminAccounts"; DO NOT EDIT.
+
+package inspector2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func listDelegatedAdminAccountsPages(ctx context.Context, conn *inspector2.Client, input *inspector2.ListDelegatedAdminAccountsInput, fn func(*inspector2.ListDelegatedAdminAccountsOutput, bool) bool) error {
+	pages := inspector2.NewListDelegatedAdminAccountsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !fn(page, !pages.HasMorePages()) {
+			break
+		}
+	}
+	return nil
+}
+
+func findDelegatedAdminAccounts(ctx context.Context, conn *inspector2.Client, input *inspector2.ListDelegatedAdminAccountsInput, filter tfslices.Predicate[awstypes.DelegatedAdminAccount]) ([]awstypes.DelegatedAdminAccount, error) {
+	var output []awstypes.DelegatedAdminAccount
+
+	err := listDelegatedAdminAccountsPages(ctx, conn, input, func(page *inspector2.ListDelegatedAdminAccountsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, tfslices.Filter(page.DelegatedAdminAccounts, filter)...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findDelegatedAdminAccount(ctx context.Context, conn *inspector2.Client, input *inspector2.ListDelegatedAdminAccountsInput, filter tfslices.Predicate[awstypes.DelegatedAdminAccount]) (*awstypes.DelegatedAdminAccount, error) {
+	var output []awstypes.DelegatedAdminAccount
+
+	// Stop as soon as more than one match is found.
+	err := listDelegatedAdminAccountsPages(ctx, conn, input, func(page *inspector2.ListDelegatedAdminAccountsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, tfslices.Filter(page.DelegatedAdminAccounts, filter)...)
+
+		return len(output) <= 1 && !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var validName = validation.All(
+	validation.StringLenBetween(1, 128),
+	validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_]+$`), "must contain only alphanumeric characters and underscores"),
+)
+
+// @SDKResource("aws_iotanalytics_channel", name="Channel")
+// @Tags(identifierAttribute="arn")
+func ResourceChannel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceChannelCreate,
+		ReadWithoutTimeout:   resourceChannelRead,
+		UpdateWithoutTimeout: resourceChannelUpdate,
+		DeleteWithoutTimeout: resourceChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"retention_period": retentionPeriodSchema(),
+			"storage": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"customer_managed_s3": customerManagedS3Schema("storage.0.service_managed_s3"),
+						"service_managed_s3":  emptyBlockSchema("storage.0.customer_managed_s3"),
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreateChannelInput{
+		ChannelName:     aws.String(name),
+		ChannelStorage:  expandChannelStorage(d.Get("storage").([]interface{})),
+		RetentionPeriod: expandRetentionPeriod(d.Get("retention_period").([]interface{})),
+		Tags:            getTagsIn(ctx),
+	}
+
+	_, err := conn.CreateChannelWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating IoT Analytics Channel (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceChannelRead(ctx, d, meta)
+}
+
+func resourceChannelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	channel, err := FindChannelByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Channel %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IoT Analytics Channel (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", channel.Arn)
+	d.Set("name", channel.Name)
+	if err := d.Set("retention_period", flattenRetentionPeriod(channel.RetentionPeriod)); err != nil {
+		return diag.Errorf("setting retention_period: %s", err)
+	}
+	if err := d.Set("storage", flattenChannelStorage(channel.Storage)); err != nil {
+		return diag.Errorf("setting storage: %s", err)
+	}
+
+	return nil
+}
+
+func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	if d.HasChanges("retention_period", "storage") {
+		input := &iotanalytics.UpdateChannelInput{
+			ChannelName:     aws.String(d.Id()),
+			ChannelStorage:  expandChannelStorage(d.Get("storage").([]interface{})),
+			RetentionPeriod: expandRetentionPeriod(d.Get("retention_period").([]interface{})),
+		}
+
+		_, err := conn.UpdateChannelWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating IoT Analytics Channel (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceChannelRead(ctx, d, meta)
+}
+
+func resourceChannelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	log.Printf("[INFO] Deleting IoT Analytics Channel: %s", d.Id())
+	_, err := conn.DeleteChannelWithContext(ctx, &iotanalytics.DeleteChannelInput{
+		ChannelName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting IoT Analytics Channel (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindChannelByName(ctx context.Context, conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Channel, error) {
+	input := &iotanalytics.DescribeChannelInput{
+		ChannelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeChannelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Channel == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Channel, nil
+}
+
+func expandChannelStorage(tfList []interface{}) *iotanalytics.ChannelStorage {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotanalytics.ChannelStorage{}
+
+	if v, ok := tfMap["customer_managed_s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.CustomerManagedS3 = &iotanalytics.CustomerManagedChannelS3Storage{
+			Bucket:  aws.String(tfMap["bucket"].(string)),
+			RoleArn: aws.String(tfMap["role_arn"].(string)),
+		}
+
+		if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+			apiObject.CustomerManagedS3.KeyPrefix = aws.String(v)
+		}
+	} else {
+		apiObject.ServiceManagedS3 = &iotanalytics.ServiceManagedChannelS3Storage{}
+	}
+
+	return apiObject
+}
+
+func flattenChannelStorage(apiObject *iotanalytics.ChannelStorage) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomerManagedS3; v != nil {
+		tfMap["customer_managed_s3"] = []interface{}{map[string]interface{}{
+			"bucket":     aws.StringValue(v.Bucket),
+			"key_prefix": aws.StringValue(v.KeyPrefix),
+			"role_arn":   aws.StringValue(v.RoleArn),
+		}}
+	}
+
+	if apiObject.ServiceManagedS3 != nil {
+		tfMap["service_managed_s3"] = []interface{}{map[string]interface{}{}}
+	}
+
+	return []interface{}{tfMap}
+}
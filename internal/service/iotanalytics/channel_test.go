@@ -0,0 +1,367 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotanalytics "github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTAnalyticsChannel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Channel
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "iotanalytics", fmt.Sprintf("channel/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.unlimited", "true"),
+					resource.TestCheckResourceAttr(resourceName, "storage.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.customer_managed_s3.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.service_managed_s3.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsChannel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Channel
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotanalytics.ResourceChannel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsChannel_customerManagedS3(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Channel
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_customerManagedS3(rName, "prefix/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.customer_managed_s3.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage.0.customer_managed_s3.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.customer_managed_s3.0.key_prefix", "prefix/"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage.0.customer_managed_s3.0.role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.service_managed_s3.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccChannelConfig_customerManagedS3(rName, "updated/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.customer_managed_s3.0.key_prefix", "updated/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsChannel_retentionPeriod(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Channel
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_retentionPeriod(rName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.number_of_days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.unlimited", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccChannelConfig_retentionPeriod(rName, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.number_of_days", "60"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsChannel_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Channel
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccChannelConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccChannelConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckChannelExists(ctx context.Context, n string, v *iotanalytics.Channel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Analytics Channel ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+		output, err := tfiotanalytics.FindChannelByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckChannelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotanalytics_channel" {
+				continue
+			}
+
+			_, err := tfiotanalytics.FindChannelByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Analytics Channel %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccConfig_customerManagedS3Base creates an S3 bucket and an IAM role that
+// IoT Analytics can assume to read and write objects in it.
+func testAccConfig_customerManagedS3Base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = replace(%[1]q, "_", "-")
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "iotanalytics.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "s3:GetBucketLocation",
+        "s3:GetObject",
+        "s3:ListBucket",
+        "s3:ListBucketMultipartUploads",
+        "s3:ListMultipartUploadParts",
+        "s3:AbortMultipartUpload",
+        "s3:PutObject",
+        "s3:DeleteObject",
+      ]
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccChannelConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccChannelConfig_customerManagedS3(rName, keyPrefix string) string {
+	return acctest.ConfigCompose(testAccConfig_customerManagedS3Base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+
+  storage {
+    customer_managed_s3 {
+      bucket     = aws_s3_bucket.test.bucket
+      key_prefix = %[2]q
+      role_arn   = aws_iam_role.test.arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, keyPrefix))
+}
+
+func testAccChannelConfig_retentionPeriod(rName string, days int) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+
+  retention_period {
+    number_of_days = %[2]d
+  }
+}
+`, rName, days)
+}
+
+func testAccChannelConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccChannelConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
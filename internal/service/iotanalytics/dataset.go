@@ -0,0 +1,983 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotanalytics_dataset", name="Dataset")
+// @Tags(identifierAttribute="arn")
+func ResourceDataset() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDatasetCreate,
+		ReadWithoutTimeout:   resourceDatasetRead,
+		UpdateWithoutTimeout: resourceDatasetUpdate,
+		DeleteWithoutTimeout: resourceDatasetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"execution_role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"image": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"resource_configuration": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"compute_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(iotanalytics.ComputeType_Values(), false),
+												},
+												"volume_size_in_gb": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(1, 50),
+												},
+											},
+										},
+									},
+									"variable": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 50,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"dataset_content_version_value": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"dataset_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validName,
+															},
+														},
+													},
+												},
+												"double_value": {
+													Type:     schema.TypeFloat,
+													Optional: true,
+												},
+												"name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+												"output_file_uri_value": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"file_name": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+														},
+													},
+												},
+												"string_value": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 1024),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"query_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"filter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delta_time": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"offset_seconds": {
+																Type:     schema.TypeInt,
+																Required: true,
+															},
+															"time_expression": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"sql_query": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_delivery_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"iot_events_destination_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"input_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"role_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+									"s3_destination_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(3, 255),
+												},
+												"glue_configuration": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"database_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 150),
+															},
+															"table_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 150),
+															},
+														},
+													},
+												},
+												"key": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+												"role_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"entry_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"late_data_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delta_time_session_window_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"timeout_in_minutes": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(1, 60),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"rule_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"retention_period": retentionPeriodSchema(),
+			names.AttrTags:     tftags.TagsSchema(),
+			names.AttrTagsAll:  tftags.TagsSchemaComputed(),
+			"trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dataset": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validName,
+									},
+								},
+							},
+						},
+						"schedule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expression": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"versioning_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_versions": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntBetween(1, 1000),
+							ConflictsWith: []string{"versioning_configuration.0.unlimited"},
+						},
+						"unlimited": {
+							Type:          schema.TypeBool,
+							Optional:      true,
+							ConflictsWith: []string{"versioning_configuration.0.max_versions"},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDatasetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreateDatasetInput{
+		Actions:                 expandDatasetActions(d.Get("action").([]interface{})),
+		ContentDeliveryRules:    expandDatasetContentDeliveryRules(d.Get("content_delivery_rule").([]interface{})),
+		DatasetName:             aws.String(name),
+		LateDataRules:           expandLateDataRules(d.Get("late_data_rule").([]interface{})),
+		RetentionPeriod:         expandRetentionPeriod(d.Get("retention_period").([]interface{})),
+		Tags:                    getTagsIn(ctx),
+		Triggers:                expandDatasetTriggers(d.Get("trigger").([]interface{})),
+		VersioningConfiguration: expandVersioningConfiguration(d.Get("versioning_configuration").([]interface{})),
+	}
+
+	_, err := conn.CreateDatasetWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating IoT Analytics Dataset (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceDatasetRead(ctx, d, meta)
+}
+
+func resourceDatasetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	dataset, err := FindDatasetByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Dataset %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IoT Analytics Dataset (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("action", flattenDatasetActions(dataset.Actions)); err != nil {
+		return diag.Errorf("setting action: %s", err)
+	}
+	d.Set("arn", dataset.Arn)
+	if err := d.Set("content_delivery_rule", flattenDatasetContentDeliveryRules(dataset.ContentDeliveryRules)); err != nil {
+		return diag.Errorf("setting content_delivery_rule: %s", err)
+	}
+	if err := d.Set("late_data_rule", flattenLateDataRules(dataset.LateDataRules)); err != nil {
+		return diag.Errorf("setting late_data_rule: %s", err)
+	}
+	d.Set("name", dataset.Name)
+	if err := d.Set("retention_period", flattenRetentionPeriod(dataset.RetentionPeriod)); err != nil {
+		return diag.Errorf("setting retention_period: %s", err)
+	}
+	if err := d.Set("trigger", flattenDatasetTriggers(dataset.Triggers)); err != nil {
+		return diag.Errorf("setting trigger: %s", err)
+	}
+	if err := d.Set("versioning_configuration", flattenVersioningConfiguration(dataset.VersioningConfiguration)); err != nil {
+		return diag.Errorf("setting versioning_configuration: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDatasetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotanalytics.UpdateDatasetInput{
+			Actions:                 expandDatasetActions(d.Get("action").([]interface{})),
+			ContentDeliveryRules:    expandDatasetContentDeliveryRules(d.Get("content_delivery_rule").([]interface{})),
+			DatasetName:             aws.String(d.Id()),
+			LateDataRules:           expandLateDataRules(d.Get("late_data_rule").([]interface{})),
+			RetentionPeriod:         expandRetentionPeriod(d.Get("retention_period").([]interface{})),
+			Triggers:                expandDatasetTriggers(d.Get("trigger").([]interface{})),
+			VersioningConfiguration: expandVersioningConfiguration(d.Get("versioning_configuration").([]interface{})),
+		}
+
+		_, err := conn.UpdateDatasetWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating IoT Analytics Dataset (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceDatasetRead(ctx, d, meta)
+}
+
+func resourceDatasetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	log.Printf("[INFO] Deleting IoT Analytics Dataset: %s", d.Id())
+	_, err := conn.DeleteDatasetWithContext(ctx, &iotanalytics.DeleteDatasetInput{
+		DatasetName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting IoT Analytics Dataset (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindDatasetByName(ctx context.Context, conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Dataset, error) {
+	input := &iotanalytics.DescribeDatasetInput{
+		DatasetName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDatasetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Dataset == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Dataset, nil
+}
+
+func expandDatasetActions(tfList []interface{}) []*iotanalytics.DatasetAction {
+	var apiObjects []*iotanalytics.DatasetAction
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotanalytics.DatasetAction{
+			ActionName: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["container_action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ContainerAction = expandContainerDatasetAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["query_action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.QueryAction = expandSQLQueryDatasetAction(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandContainerDatasetAction(tfMap map[string]interface{}) *iotanalytics.ContainerDatasetAction {
+	apiObject := &iotanalytics.ContainerDatasetAction{
+		ExecutionRoleArn: aws.String(tfMap["execution_role_arn"].(string)),
+		Image:            aws.String(tfMap["image"].(string)),
+	}
+
+	if v, ok := tfMap["resource_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.ResourceConfiguration = &iotanalytics.ResourceConfiguration{
+			ComputeType:    aws.String(tfMap["compute_type"].(string)),
+			VolumeSizeInGB: aws.Int64(int64(tfMap["volume_size_in_gb"].(int))),
+		}
+	}
+
+	if v, ok := tfMap["variable"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			variable := &iotanalytics.Variable{
+				Name: aws.String(tfMap["name"].(string)),
+			}
+
+			if v, ok := tfMap["dataset_content_version_value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				variable.DatasetContentVersionValue = &iotanalytics.DatasetContentVersionValue{
+					DatasetName: aws.String(v[0].(map[string]interface{})["dataset_name"].(string)),
+				}
+			}
+
+			if v, ok := tfMap["double_value"].(float64); ok && v != 0 {
+				variable.DoubleValue = aws.Float64(v)
+			}
+
+			if v, ok := tfMap["output_file_uri_value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				variable.OutputFileUriValue = &iotanalytics.OutputFileUriValue{
+					FileName: aws.String(v[0].(map[string]interface{})["file_name"].(string)),
+				}
+			}
+
+			if v, ok := tfMap["string_value"].(string); ok && v != "" {
+				variable.StringValue = aws.String(v)
+			}
+
+			apiObject.Variables = append(apiObject.Variables, variable)
+		}
+	}
+
+	return apiObject
+}
+
+func expandSQLQueryDatasetAction(tfMap map[string]interface{}) *iotanalytics.SqlQueryDatasetAction {
+	apiObject := &iotanalytics.SqlQueryDatasetAction{
+		SqlQuery: aws.String(tfMap["sql_query"].(string)),
+	}
+
+	if v, ok := tfMap["filter"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			filter := &iotanalytics.QueryFilter{}
+
+			if v, ok := tfMap["delta_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+
+				filter.DeltaTime = &iotanalytics.DeltaTime{
+					OffsetSeconds:  aws.Int64(int64(tfMap["offset_seconds"].(int))),
+					TimeExpression: aws.String(tfMap["time_expression"].(string)),
+				}
+			}
+
+			apiObject.Filters = append(apiObject.Filters, filter)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenDatasetActions(apiObjects []*iotanalytics.DatasetAction) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name": aws.StringValue(apiObject.ActionName),
+		}
+
+		if v := apiObject.ContainerAction; v != nil {
+			tfMap["container_action"] = []interface{}{flattenContainerDatasetAction(v)}
+		}
+
+		if v := apiObject.QueryAction; v != nil {
+			tfMap["query_action"] = []interface{}{flattenSQLQueryDatasetAction(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenContainerDatasetAction(apiObject *iotanalytics.ContainerDatasetAction) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"execution_role_arn": aws.StringValue(apiObject.ExecutionRoleArn),
+		"image":              aws.StringValue(apiObject.Image),
+	}
+
+	if v := apiObject.ResourceConfiguration; v != nil {
+		tfMap["resource_configuration"] = []interface{}{map[string]interface{}{
+			"compute_type":      aws.StringValue(v.ComputeType),
+			"volume_size_in_gb": aws.Int64Value(v.VolumeSizeInGB),
+		}}
+	}
+
+	var tfList []interface{}
+
+	for _, v := range apiObject.Variables {
+		if v == nil {
+			continue
+		}
+
+		tfMapVariable := map[string]interface{}{
+			"double_value": aws.Float64Value(v.DoubleValue),
+			"name":         aws.StringValue(v.Name),
+			"string_value": aws.StringValue(v.StringValue),
+		}
+
+		if v := v.DatasetContentVersionValue; v != nil {
+			tfMapVariable["dataset_content_version_value"] = []interface{}{map[string]interface{}{
+				"dataset_name": aws.StringValue(v.DatasetName),
+			}}
+		}
+
+		if v := v.OutputFileUriValue; v != nil {
+			tfMapVariable["output_file_uri_value"] = []interface{}{map[string]interface{}{
+				"file_name": aws.StringValue(v.FileName),
+			}}
+		}
+
+		tfList = append(tfList, tfMapVariable)
+	}
+
+	tfMap["variable"] = tfList
+
+	return tfMap
+}
+
+func flattenSQLQueryDatasetAction(apiObject *iotanalytics.SqlQueryDatasetAction) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"sql_query": aws.StringValue(apiObject.SqlQuery),
+	}
+
+	var tfList []interface{}
+
+	for _, v := range apiObject.Filters {
+		if v == nil {
+			continue
+		}
+
+		tfMapFilter := map[string]interface{}{}
+
+		if v := v.DeltaTime; v != nil {
+			tfMapFilter["delta_time"] = []interface{}{map[string]interface{}{
+				"offset_seconds":  aws.Int64Value(v.OffsetSeconds),
+				"time_expression": aws.StringValue(v.TimeExpression),
+			}}
+		}
+
+		tfList = append(tfList, tfMapFilter)
+	}
+
+	tfMap["filter"] = tfList
+
+	return tfMap
+}
+
+func expandDatasetContentDeliveryRules(tfList []interface{}) []*iotanalytics.DatasetContentDeliveryRule {
+	var apiObjects []*iotanalytics.DatasetContentDeliveryRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotanalytics.DatasetContentDeliveryRule{
+			Destination: &iotanalytics.DatasetContentDeliveryDestination{},
+		}
+
+		if v, ok := tfMap["destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			if v, ok := tfMap["iot_events_destination_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+
+				apiObject.Destination.IotEventsDestinationConfiguration = &iotanalytics.IotEventsDestinationConfiguration{
+					InputName: aws.String(tfMap["input_name"].(string)),
+					RoleArn:   aws.String(tfMap["role_arn"].(string)),
+				}
+			}
+
+			if v, ok := tfMap["s3_destination_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+
+				apiObject.Destination.S3DestinationConfiguration = &iotanalytics.S3DestinationConfiguration{
+					Bucket:  aws.String(tfMap["bucket"].(string)),
+					Key:     aws.String(tfMap["key"].(string)),
+					RoleArn: aws.String(tfMap["role_arn"].(string)),
+				}
+
+				if v, ok := tfMap["glue_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+					tfMap := v[0].(map[string]interface{})
+
+					apiObject.Destination.S3DestinationConfiguration.GlueConfiguration = &iotanalytics.GlueConfiguration{
+						DatabaseName: aws.String(tfMap["database_name"].(string)),
+						TableName:    aws.String(tfMap["table_name"].(string)),
+					}
+				}
+			}
+		}
+
+		if v, ok := tfMap["entry_name"].(string); ok && v != "" {
+			apiObject.EntryName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenDatasetContentDeliveryRules(apiObjects []*iotanalytics.DatasetContentDeliveryRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"entry_name": aws.StringValue(apiObject.EntryName),
+		}
+
+		if v := apiObject.Destination; v != nil {
+			tfMapDestination := map[string]interface{}{}
+
+			if v := v.IotEventsDestinationConfiguration; v != nil {
+				tfMapDestination["iot_events_destination_configuration"] = []interface{}{map[string]interface{}{
+					"input_name": aws.StringValue(v.InputName),
+					"role_arn":   aws.StringValue(v.RoleArn),
+				}}
+			}
+
+			if v := v.S3DestinationConfiguration; v != nil {
+				tfMapS3 := map[string]interface{}{
+					"bucket":   aws.StringValue(v.Bucket),
+					"key":      aws.StringValue(v.Key),
+					"role_arn": aws.StringValue(v.RoleArn),
+				}
+
+				if v := v.GlueConfiguration; v != nil {
+					tfMapS3["glue_configuration"] = []interface{}{map[string]interface{}{
+						"database_name": aws.StringValue(v.DatabaseName),
+						"table_name":    aws.StringValue(v.TableName),
+					}}
+				}
+
+				tfMapDestination["s3_destination_configuration"] = []interface{}{tfMapS3}
+			}
+
+			tfMap["destination"] = []interface{}{tfMapDestination}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandLateDataRules(tfList []interface{}) []*iotanalytics.LateDataRule {
+	var apiObjects []*iotanalytics.LateDataRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotanalytics.LateDataRule{
+			RuleConfiguration: &iotanalytics.LateDataRuleConfiguration{},
+		}
+
+		if v, ok := tfMap["rule_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			if v, ok := tfMap["delta_time_session_window_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+
+				apiObject.RuleConfiguration.DeltaTimeSessionWindowConfiguration = &iotanalytics.DeltaTimeSessionWindowConfiguration{
+					TimeoutInMinutes: aws.Int64(int64(tfMap["timeout_in_minutes"].(int))),
+				}
+			}
+		}
+
+		if v, ok := tfMap["rule_name"].(string); ok && v != "" {
+			apiObject.RuleName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenLateDataRules(apiObjects []*iotanalytics.LateDataRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"rule_name": aws.StringValue(apiObject.RuleName),
+		}
+
+		if v := apiObject.RuleConfiguration; v != nil {
+			tfMapConfiguration := map[string]interface{}{}
+
+			if v := v.DeltaTimeSessionWindowConfiguration; v != nil {
+				tfMapConfiguration["delta_time_session_window_configuration"] = []interface{}{map[string]interface{}{
+					"timeout_in_minutes": aws.Int64Value(v.TimeoutInMinutes),
+				}}
+			}
+
+			tfMap["rule_configuration"] = []interface{}{tfMapConfiguration}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandDatasetTriggers(tfList []interface{}) []*iotanalytics.DatasetTrigger {
+	var apiObjects []*iotanalytics.DatasetTrigger
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotanalytics.DatasetTrigger{}
+
+		if v, ok := tfMap["dataset"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Dataset = &iotanalytics.TriggeringDataset{
+				Name: aws.String(v[0].(map[string]interface{})["name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["schedule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Schedule = &iotanalytics.Schedule{
+				Expression: aws.String(v[0].(map[string]interface{})["expression"].(string)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenDatasetTriggers(apiObjects []*iotanalytics.DatasetTrigger) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Dataset; v != nil {
+			tfMap["dataset"] = []interface{}{map[string]interface{}{
+				"name": aws.StringValue(v.Name),
+			}}
+		}
+
+		if v := apiObject.Schedule; v != nil {
+			tfMap["schedule"] = []interface{}{map[string]interface{}{
+				"expression": aws.StringValue(v.Expression),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandVersioningConfiguration(tfList []interface{}) *iotanalytics.VersioningConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotanalytics.VersioningConfiguration{}
+
+	if v, ok := tfMap["max_versions"].(int); ok && v > 0 {
+		apiObject.MaxVersions = aws.Int64(int64(v))
+	} else {
+		apiObject.Unlimited = aws.Bool(true)
+	}
+
+	return apiObject
+}
+
+func flattenVersioningConfiguration(apiObject *iotanalytics.VersioningConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"unlimited": aws.BoolValue(apiObject.Unlimited),
+	}
+
+	if v := apiObject.MaxVersions; v != nil {
+		tfMap["max_versions"] = aws.Int64Value(v)
+	}
+
+	return []interface{}{tfMap}
+}
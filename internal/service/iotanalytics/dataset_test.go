@@ -0,0 +1,354 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotanalytics "github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTAnalyticsDataset_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Dataset
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_dataset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.container_action.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.name", "query"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.filter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.sql_query", fmt.Sprintf("SELECT * FROM %s", rName)),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "iotanalytics", fmt.Sprintf("dataset/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "content_delivery_rule.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "late_data_rule.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDataset_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Dataset
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_dataset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotanalytics.ResourceDataset(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDataset_full(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Dataset
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_dataset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_full(rName, "rate(1 day)", 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.filter.0.delta_time.0.offset_seconds", "-60"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.filter.0.delta_time.0.time_expression", "from_unixtime(time)"),
+					resource.TestCheckResourceAttr(resourceName, "content_delivery_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "content_delivery_rule.0.entry_name", "s3"),
+					resource.TestCheckResourceAttrPair(resourceName, "content_delivery_rule.0.destination.0.s3_destination_configuration.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "content_delivery_rule.0.destination.0.s3_destination_configuration.0.key", "dataset/!{iotanalytics:scheduleTime}/!{iotanalytics:versionId}.csv"),
+					resource.TestCheckResourceAttrPair(resourceName, "content_delivery_rule.0.destination.0.s3_destination_configuration.0.role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "late_data_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "late_data_rule.0.rule_configuration.0.delta_time_session_window_configuration.0.timeout_in_minutes", "5"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.number_of_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.schedule.0.expression", "rate(1 day)"),
+					resource.TestCheckResourceAttr(resourceName, "versioning_configuration.0.max_versions", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDatasetConfig_full(rName, "rate(12 hours)", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "late_data_rule.0.rule_configuration.0.delta_time_session_window_configuration.0.timeout_in_minutes", "10"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.schedule.0.expression", "rate(12 hours)"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDataset_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Dataset
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_dataset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDatasetConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccDatasetConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+func testAccCheckDatasetExists(ctx context.Context, n string, v *iotanalytics.Dataset) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Analytics Dataset ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+		output, err := tfiotanalytics.FindDatasetByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDatasetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotanalytics_dataset" {
+				continue
+			}
+
+			_, err := tfiotanalytics.FindDatasetByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Analytics Dataset %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccDatasetConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccDatasetConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_dataset" "test" {
+  name = %[1]q
+
+  action {
+    name = "query"
+
+    query_action {
+      sql_query = "SELECT * FROM ${aws_iotanalytics_datastore.test.name}"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccDatasetConfig_full(rName, scheduleExpression string, lateDataTimeout int) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_base(rName), testAccConfig_customerManagedS3Base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_dataset" "test" {
+  name = %[1]q
+
+  action {
+    name = "query"
+
+    query_action {
+      sql_query = "SELECT * FROM ${aws_iotanalytics_datastore.test.name}"
+
+      filter {
+        delta_time {
+          offset_seconds  = -60
+          time_expression = "from_unixtime(time)"
+        }
+      }
+    }
+  }
+
+  content_delivery_rule {
+    entry_name = "s3"
+
+    destination {
+      s3_destination_configuration {
+        bucket   = aws_s3_bucket.test.bucket
+        key      = "dataset/!{iotanalytics:scheduleTime}/!{iotanalytics:versionId}.csv"
+        role_arn = aws_iam_role.test.arn
+      }
+    }
+  }
+
+  late_data_rule {
+    rule_name = "late"
+
+    rule_configuration {
+      delta_time_session_window_configuration {
+        timeout_in_minutes = %[3]d
+      }
+    }
+  }
+
+  retention_period {
+    number_of_days = 7
+  }
+
+  trigger {
+    schedule {
+      expression = %[2]q
+    }
+  }
+
+  versioning_configuration {
+    max_versions = 3
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, scheduleExpression, lateDataTimeout))
+}
+
+func testAccDatasetConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_dataset" "test" {
+  name = %[1]q
+
+  action {
+    name = "query"
+
+    query_action {
+      sql_query = "SELECT * FROM ${aws_iotanalytics_datastore.test.name}"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccDatasetConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_dataset" "test" {
+  name = %[1]q
+
+  action {
+    name = "query"
+
+    query_action {
+      sql_query = "SELECT * FROM ${aws_iotanalytics_datastore.test.name}"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,512 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotanalytics_datastore", name="Datastore")
+// @Tags(identifierAttribute="arn")
+func ResourceDatastore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDatastoreCreate,
+		ReadWithoutTimeout:   resourceDatastoreRead,
+		UpdateWithoutTimeout: resourceDatastoreUpdate,
+		DeleteWithoutTimeout: resourceDatastoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_partitions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"partition": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 25,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute_partition": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"attribute_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+											},
+										},
+									},
+									"timestamp_partition": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"attribute_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"timestamp_format": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 50),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"file_format_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"json_configuration": emptyBlockSchema("file_format_configuration.0.parquet_configuration"),
+						"parquet_configuration": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"file_format_configuration.0.json_configuration"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"schema_definition": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"column": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 100,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 255),
+															},
+															"type": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 131072),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"retention_period": retentionPeriodSchema(),
+			"storage": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"customer_managed_s3": customerManagedS3Schema("storage.0.service_managed_s3"),
+						"service_managed_s3":  emptyBlockSchema("storage.0.customer_managed_s3"),
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDatastoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreateDatastoreInput{
+		DatastoreName:           aws.String(name),
+		DatastorePartitions:     expandDatastorePartitions(d.Get("datastore_partitions").([]interface{})),
+		DatastoreStorage:        expandDatastoreStorage(d.Get("storage").([]interface{})),
+		FileFormatConfiguration: expandFileFormatConfiguration(d.Get("file_format_configuration").([]interface{})),
+		RetentionPeriod:         expandRetentionPeriod(d.Get("retention_period").([]interface{})),
+		Tags:                    getTagsIn(ctx),
+	}
+
+	_, err := conn.CreateDatastoreWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating IoT Analytics Datastore (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceDatastoreRead(ctx, d, meta)
+}
+
+func resourceDatastoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	datastore, err := FindDatastoreByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Datastore %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IoT Analytics Datastore (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", datastore.Arn)
+	if err := d.Set("datastore_partitions", flattenDatastorePartitions(datastore.DatastorePartitions)); err != nil {
+		return diag.Errorf("setting datastore_partitions: %s", err)
+	}
+	if err := d.Set("file_format_configuration", flattenFileFormatConfiguration(datastore.FileFormatConfiguration)); err != nil {
+		return diag.Errorf("setting file_format_configuration: %s", err)
+	}
+	d.Set("name", datastore.Name)
+	if err := d.Set("retention_period", flattenRetentionPeriod(datastore.RetentionPeriod)); err != nil {
+		return diag.Errorf("setting retention_period: %s", err)
+	}
+	if err := d.Set("storage", flattenDatastoreStorage(datastore.Storage)); err != nil {
+		return diag.Errorf("setting storage: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDatastoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	if d.HasChanges("file_format_configuration", "retention_period", "storage") {
+		input := &iotanalytics.UpdateDatastoreInput{
+			DatastoreName:           aws.String(d.Id()),
+			DatastoreStorage:        expandDatastoreStorage(d.Get("storage").([]interface{})),
+			FileFormatConfiguration: expandFileFormatConfiguration(d.Get("file_format_configuration").([]interface{})),
+			RetentionPeriod:         expandRetentionPeriod(d.Get("retention_period").([]interface{})),
+		}
+
+		_, err := conn.UpdateDatastoreWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating IoT Analytics Datastore (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceDatastoreRead(ctx, d, meta)
+}
+
+func resourceDatastoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	log.Printf("[INFO] Deleting IoT Analytics Datastore: %s", d.Id())
+	_, err := conn.DeleteDatastoreWithContext(ctx, &iotanalytics.DeleteDatastoreInput{
+		DatastoreName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting IoT Analytics Datastore (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindDatastoreByName(ctx context.Context, conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Datastore, error) {
+	input := &iotanalytics.DescribeDatastoreInput{
+		DatastoreName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDatastoreWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Datastore == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Datastore, nil
+}
+
+func expandDatastoreStorage(tfList []interface{}) *iotanalytics.DatastoreStorage {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotanalytics.DatastoreStorage{}
+
+	if v, ok := tfMap["customer_managed_s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.CustomerManagedS3 = &iotanalytics.CustomerManagedDatastoreS3Storage{
+			Bucket:  aws.String(tfMap["bucket"].(string)),
+			RoleArn: aws.String(tfMap["role_arn"].(string)),
+		}
+
+		if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+			apiObject.CustomerManagedS3.KeyPrefix = aws.String(v)
+		}
+	} else {
+		apiObject.ServiceManagedS3 = &iotanalytics.ServiceManagedDatastoreS3Storage{}
+	}
+
+	return apiObject
+}
+
+func flattenDatastoreStorage(apiObject *iotanalytics.DatastoreStorage) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomerManagedS3; v != nil {
+		tfMap["customer_managed_s3"] = []interface{}{map[string]interface{}{
+			"bucket":     aws.StringValue(v.Bucket),
+			"key_prefix": aws.StringValue(v.KeyPrefix),
+			"role_arn":   aws.StringValue(v.RoleArn),
+		}}
+	}
+
+	if apiObject.ServiceManagedS3 != nil {
+		tfMap["service_managed_s3"] = []interface{}{map[string]interface{}{}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandFileFormatConfiguration(tfList []interface{}) *iotanalytics.FileFormatConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotanalytics.FileFormatConfiguration{}
+
+	if v, ok := tfMap["parquet_configuration"]; ok && isBlockPresent(v) {
+		apiObject.ParquetConfiguration = &iotanalytics.ParquetConfiguration{}
+
+		if tfMap, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["schema_definition"].([]interface{}); ok && len(v) > 0 {
+				apiObject.ParquetConfiguration.SchemaDefinition = expandSchemaDefinition(v)
+			}
+		}
+	} else {
+		apiObject.JsonConfiguration = &iotanalytics.JsonConfiguration{}
+	}
+
+	return apiObject
+}
+
+func expandSchemaDefinition(tfList []interface{}) *iotanalytics.SchemaDefinition {
+	apiObject := &iotanalytics.SchemaDefinition{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["column"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Columns = append(apiObject.Columns, &iotanalytics.Column{
+				Name: aws.String(tfMap["name"].(string)),
+				Type: aws.String(tfMap["type"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenFileFormatConfiguration(apiObject *iotanalytics.FileFormatConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if apiObject.JsonConfiguration != nil {
+		tfMap["json_configuration"] = []interface{}{map[string]interface{}{}}
+	}
+
+	if v := apiObject.ParquetConfiguration; v != nil {
+		tfMapParquet := map[string]interface{}{}
+
+		if v := v.SchemaDefinition; v != nil {
+			var tfList []interface{}
+
+			for _, v := range v.Columns {
+				if v == nil {
+					continue
+				}
+
+				tfList = append(tfList, map[string]interface{}{
+					"name": aws.StringValue(v.Name),
+					"type": aws.StringValue(v.Type),
+				})
+			}
+
+			tfMapParquet["schema_definition"] = []interface{}{map[string]interface{}{
+				"column": tfList,
+			}}
+		}
+
+		tfMap["parquet_configuration"] = []interface{}{tfMapParquet}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandDatastorePartitions(tfList []interface{}) *iotanalytics.DatastorePartitions {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotanalytics.DatastorePartitions{}
+
+	for _, tfMapRaw := range tfMap["partition"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		partition := &iotanalytics.DatastorePartition{}
+
+		if v, ok := tfMap["attribute_partition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			partition.AttributePartition = &iotanalytics.Partition{
+				AttributeName: aws.String(tfMap["attribute_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["timestamp_partition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			partition.TimestampPartition = &iotanalytics.TimestampPartition{
+				AttributeName: aws.String(tfMap["attribute_name"].(string)),
+			}
+
+			if v, ok := tfMap["timestamp_format"].(string); ok && v != "" {
+				partition.TimestampPartition.TimestampFormat = aws.String(v)
+			}
+		}
+
+		apiObject.Partitions = append(apiObject.Partitions, partition)
+	}
+
+	return apiObject
+}
+
+func flattenDatastorePartitions(apiObject *iotanalytics.DatastorePartitions) []interface{} {
+	if apiObject == nil || len(apiObject.Partitions) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, v := range apiObject.Partitions {
+		if v == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := v.AttributePartition; v != nil {
+			tfMap["attribute_partition"] = []interface{}{map[string]interface{}{
+				"attribute_name": aws.StringValue(v.AttributeName),
+			}}
+		}
+
+		if v := v.TimestampPartition; v != nil {
+			tfMap["timestamp_partition"] = []interface{}{map[string]interface{}{
+				"attribute_name":   aws.StringValue(v.AttributeName),
+				"timestamp_format": aws.StringValue(v.TimestampFormat),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return []interface{}{map[string]interface{}{
+		"partition": tfList,
+	}}
+}
@@ -0,0 +1,340 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotanalytics "github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTAnalyticsDatastore_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Datastore
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "iotanalytics", fmt.Sprintf("datastore/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "datastore_partitions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.json_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.parquet_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.unlimited", "true"),
+					resource.TestCheckResourceAttr(resourceName, "storage.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.service_managed_s3.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDatastore_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Datastore
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotanalytics.ResourceDatastore(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDatastore_customerManagedS3(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Datastore
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_customerManagedS3(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.customer_managed_s3.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage.0.customer_managed_s3.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.customer_managed_s3.0.key_prefix", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "storage.0.customer_managed_s3.0.role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.service_managed_s3.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDatastore_parquet(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Datastore
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_parquet(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "datastore_partitions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "datastore_partitions.0.partition.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "datastore_partitions.0.partition.0.attribute_partition.0.attribute_name", "device_id"),
+					resource.TestCheckResourceAttr(resourceName, "datastore_partitions.0.partition.1.timestamp_partition.0.attribute_name", "event_time"),
+					resource.TestCheckResourceAttr(resourceName, "datastore_partitions.0.partition.1.timestamp_partition.0.timestamp_format", "yyyy-MM-dd HH:mm:ss"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.json_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.parquet_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.parquet_configuration.0.schema_definition.0.column.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.parquet_configuration.0.schema_definition.0.column.0.name", "device_id"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.parquet_configuration.0.schema_definition.0.column.0.type", "string"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDatastore_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Datastore
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDatastoreConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccDatastoreConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+func testAccCheckDatastoreExists(ctx context.Context, n string, v *iotanalytics.Datastore) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Analytics Datastore ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+		output, err := tfiotanalytics.FindDatastoreByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDatastoreDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotanalytics_datastore" {
+				continue
+			}
+
+			_, err := tfiotanalytics.FindDatastoreByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Analytics Datastore %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccDatastoreConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccDatastoreConfig_customerManagedS3(rName string) string {
+	return acctest.ConfigCompose(testAccConfig_customerManagedS3Base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+
+  storage {
+    customer_managed_s3 {
+      bucket   = aws_s3_bucket.test.bucket
+      role_arn = aws_iam_role.test.arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccDatastoreConfig_parquet(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+
+  file_format_configuration {
+    parquet_configuration {
+      schema_definition {
+        column {
+          name = "device_id"
+          type = "string"
+        }
+
+        column {
+          name = "event_time"
+          type = "string"
+        }
+
+        column {
+          name = "temperature"
+          type = "double"
+        }
+      }
+    }
+  }
+
+  datastore_partitions {
+    partition {
+      attribute_partition {
+        attribute_name = "device_id"
+      }
+    }
+
+    partition {
+      timestamp_partition {
+        attribute_name   = "event_time"
+        timestamp_format = "yyyy-MM-dd HH:mm:ss"
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccDatastoreConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccDatastoreConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
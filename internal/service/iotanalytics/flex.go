@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func retentionPeriodSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"number_of_days": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntAtLeast(1),
+					ConflictsWith: []string{"retention_period.0.unlimited"},
+				},
+				"unlimited": {
+					Type:          schema.TypeBool,
+					Optional:      true,
+					ConflictsWith: []string{"retention_period.0.number_of_days"},
+				},
+			},
+		},
+	}
+}
+
+func expandRetentionPeriod(tfList []interface{}) *iotanalytics.RetentionPeriod {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotanalytics.RetentionPeriod{}
+
+	if v, ok := tfMap["number_of_days"].(int); ok && v > 0 {
+		apiObject.NumberOfDays = aws.Int64(int64(v))
+	} else {
+		apiObject.Unlimited = aws.Bool(true)
+	}
+
+	return apiObject
+}
+
+func flattenRetentionPeriod(apiObject *iotanalytics.RetentionPeriod) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"unlimited": aws.BoolValue(apiObject.Unlimited),
+	}
+
+	if v := apiObject.NumberOfDays; v != nil {
+		tfMap["number_of_days"] = aws.Int64Value(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func customerManagedS3Schema(conflictsWith ...string) *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: conflictsWith,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bucket": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(3, 255),
+				},
+				"key_prefix": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+// emptyBlockSchema returns the schema of a configuration block with no arguments,
+// used to select between API union members such as service-managed S3 storage.
+func emptyBlockSchema(conflictsWith ...string) *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: conflictsWith,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{},
+		},
+	}
+}
+
+// isBlockPresent reports whether an optional configuration block is set.
+// Blocks with no arguments are represented as a list containing a single nil.
+func isBlockPresent(v interface{}) bool {
+	tfList, ok := v.([]interface{})
+
+	return ok && len(tfList) > 0
+}
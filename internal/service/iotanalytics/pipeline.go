@@ -0,0 +1,600 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotanalytics_pipeline", name="Pipeline")
+// @Tags(identifierAttribute="arn")
+func ResourcePipeline() *schema.Resource {
+	enrichActivitySchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"attribute": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 256),
+					},
+					"role_arn": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: verify.ValidARN,
+					},
+					"thing_name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 256),
+					},
+				},
+			},
+		}
+	}
+
+	attributeListActivitySchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"attributes": {
+						Type:     schema.TypeList,
+						Required: true,
+						MinItems: 1,
+						MaxItems: 50,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePipelineCreate,
+		ReadWithoutTimeout:   resourcePipelineRead,
+		UpdateWithoutTimeout: resourcePipelineUpdate,
+		DeleteWithoutTimeout: resourcePipelineDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"activity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 2,
+				MaxItems: 25,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"add_attributes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attributes": {
+										Type:     schema.TypeMap,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"channel": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"channel_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validName,
+									},
+								},
+							},
+						},
+						"datastore": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"datastore_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validName,
+									},
+								},
+							},
+						},
+						"device_registry_enrich": enrichActivitySchema(),
+						"device_shadow_enrich":   enrichActivitySchema(),
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"filter": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+								},
+							},
+						},
+						"lambda": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"batch_size": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 1000),
+									},
+									"lambda_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+								},
+							},
+						},
+						"math": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"math": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+								},
+							},
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"remove_attributes": attributeListActivitySchema(),
+						"select_attributes": attributeListActivitySchema(),
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePipelineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreatePipelineInput{
+		PipelineActivities: expandPipelineActivities(d.Get("activity").([]interface{})),
+		PipelineName:       aws.String(name),
+		Tags:               getTagsIn(ctx),
+	}
+
+	_, err := conn.CreatePipelineWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating IoT Analytics Pipeline (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourcePipelineRead(ctx, d, meta)
+}
+
+func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	pipeline, err := FindPipelineByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Pipeline %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IoT Analytics Pipeline (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("activity", flattenPipelineActivities(pipeline.Activities)); err != nil {
+		return diag.Errorf("setting activity: %s", err)
+	}
+	d.Set("arn", pipeline.Arn)
+	d.Set("name", pipeline.Name)
+
+	return nil
+}
+
+func resourcePipelineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	if d.HasChange("activity") {
+		input := &iotanalytics.UpdatePipelineInput{
+			PipelineActivities: expandPipelineActivities(d.Get("activity").([]interface{})),
+			PipelineName:       aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdatePipelineWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating IoT Analytics Pipeline (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourcePipelineRead(ctx, d, meta)
+}
+
+func resourcePipelineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+	log.Printf("[INFO] Deleting IoT Analytics Pipeline: %s", d.Id())
+	_, err := conn.DeletePipelineWithContext(ctx, &iotanalytics.DeletePipelineInput{
+		PipelineName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting IoT Analytics Pipeline (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindPipelineByName(ctx context.Context, conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Pipeline, error) {
+	input := &iotanalytics.DescribePipelineInput{
+		PipelineName: aws.String(name),
+	}
+
+	output, err := conn.DescribePipelineWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Pipeline == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Pipeline, nil
+}
+
+// expandPipelineActivities converts the ordered activity list into API objects,
+// linking each activity to the one that follows it in configuration order.
+func expandPipelineActivities(tfList []interface{}) []*iotanalytics.PipelineActivity {
+	var apiObjects []*iotanalytics.PipelineActivity
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := aws.String(tfMap["name"].(string))
+		var next *string
+
+		if i+1 < len(tfList) {
+			if tfMap, ok := tfList[i+1].(map[string]interface{}); ok {
+				next = aws.String(tfMap["name"].(string))
+			}
+		}
+
+		apiObject := &iotanalytics.PipelineActivity{}
+
+		if v, ok := tfMap["add_attributes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.AddAttributes = &iotanalytics.AddAttributesActivity{
+				Attributes: flex.ExpandStringMap(tfMap["attributes"].(map[string]interface{})),
+				Name:       name,
+				Next:       next,
+			}
+		}
+
+		if v, ok := tfMap["channel"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Channel = &iotanalytics.ChannelActivity{
+				ChannelName: aws.String(tfMap["channel_name"].(string)),
+				Name:        name,
+				Next:        next,
+			}
+		}
+
+		if v, ok := tfMap["datastore"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Datastore = &iotanalytics.DatastoreActivity{
+				DatastoreName: aws.String(tfMap["datastore_name"].(string)),
+				Name:          name,
+			}
+		}
+
+		if v, ok := tfMap["device_registry_enrich"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.DeviceRegistryEnrich = &iotanalytics.DeviceRegistryEnrichActivity{
+				Attribute: aws.String(tfMap["attribute"].(string)),
+				Name:      name,
+				Next:      next,
+				RoleArn:   aws.String(tfMap["role_arn"].(string)),
+				ThingName: aws.String(tfMap["thing_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["device_shadow_enrich"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.DeviceShadowEnrich = &iotanalytics.DeviceShadowEnrichActivity{
+				Attribute: aws.String(tfMap["attribute"].(string)),
+				Name:      name,
+				Next:      next,
+				RoleArn:   aws.String(tfMap["role_arn"].(string)),
+				ThingName: aws.String(tfMap["thing_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Filter = &iotanalytics.FilterActivity{
+				Filter: aws.String(tfMap["filter"].(string)),
+				Name:   name,
+				Next:   next,
+			}
+		}
+
+		if v, ok := tfMap["lambda"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Lambda = &iotanalytics.LambdaActivity{
+				BatchSize:  aws.Int64(int64(tfMap["batch_size"].(int))),
+				LambdaName: aws.String(tfMap["lambda_name"].(string)),
+				Name:       name,
+				Next:       next,
+			}
+		}
+
+		if v, ok := tfMap["math"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Math = &iotanalytics.MathActivity{
+				Attribute: aws.String(tfMap["attribute"].(string)),
+				Math:      aws.String(tfMap["math"].(string)),
+				Name:      name,
+				Next:      next,
+			}
+		}
+
+		if v, ok := tfMap["remove_attributes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.RemoveAttributes = &iotanalytics.RemoveAttributesActivity{
+				Attributes: flex.ExpandStringList(tfMap["attributes"].([]interface{})),
+				Name:       name,
+				Next:       next,
+			}
+		}
+
+		if v, ok := tfMap["select_attributes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.SelectAttributes = &iotanalytics.SelectAttributesActivity{
+				Attributes: flex.ExpandStringList(tfMap["attributes"].([]interface{})),
+				Name:       name,
+				Next:       next,
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// flattenPipelineActivities returns the activities in processing order,
+// following each activity's "next" reference from the first activity.
+func flattenPipelineActivities(apiObjects []*iotanalytics.PipelineActivity) []interface{} {
+	type activity struct {
+		name  string
+		next  string
+		tfMap map[string]interface{}
+	}
+
+	var activities []*activity
+	referenced := make(map[string]bool)
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		name, next, tfMap := flattenPipelineActivity(apiObject)
+		activities = append(activities, &activity{name: name, next: next, tfMap: tfMap})
+
+		if next != "" {
+			referenced[next] = true
+		}
+	}
+
+	byName := make(map[string]*activity, len(activities))
+	for _, v := range activities {
+		byName[v.name] = v
+	}
+
+	var tfList []interface{}
+	visited := make(map[string]bool, len(activities))
+
+	for _, v := range activities {
+		if referenced[v.name] {
+			continue
+		}
+
+		for v != nil && !visited[v.name] {
+			visited[v.name] = true
+			tfList = append(tfList, v.tfMap)
+			v = byName[v.next]
+		}
+	}
+
+	// Append anything not reachable from a starting activity in API order.
+	for _, v := range activities {
+		if !visited[v.name] {
+			visited[v.name] = true
+			tfList = append(tfList, v.tfMap)
+		}
+	}
+
+	return tfList
+}
+
+func flattenPipelineActivity(apiObject *iotanalytics.PipelineActivity) (string, string, map[string]interface{}) {
+	var name, next *string
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AddAttributes; v != nil {
+		name, next = v.Name, v.Next
+		tfMap["add_attributes"] = []interface{}{map[string]interface{}{
+			"attributes": aws.StringValueMap(v.Attributes),
+		}}
+	}
+
+	if v := apiObject.Channel; v != nil {
+		name, next = v.Name, v.Next
+		tfMap["channel"] = []interface{}{map[string]interface{}{
+			"channel_name": aws.StringValue(v.ChannelName),
+		}}
+	}
+
+	if v := apiObject.Datastore; v != nil {
+		name = v.Name
+		tfMap["datastore"] = []interface{}{map[string]interface{}{
+			"datastore_name": aws.StringValue(v.DatastoreName),
+		}}
+	}
+
+	if v := apiObject.DeviceRegistryEnrich; v != nil {
+		name, next = v.Name, v.Next
+		tfMap["device_registry_enrich"] = []interface{}{map[string]interface{}{
+			"attribute":  aws.StringValue(v.Attribute),
+			"role_arn":   aws.StringValue(v.RoleArn),
+			"thing_name": aws.StringValue(v.ThingName),
+		}}
+	}
+
+	if v := apiObject.DeviceShadowEnrich; v != nil {
+		name, next = v.Name, v.Next
+		tfMap["device_shadow_enrich"] = []interface{}{map[string]interface{}{
+			"attribute":  aws.StringValue(v.Attribute),
+			"role_arn":   aws.StringValue(v.RoleArn),
+			"thing_name": aws.StringValue(v.ThingName),
+		}}
+	}
+
+	if v := apiObject.Filter; v != nil {
+		name, next = v.Name, v.Next
+		tfMap["filter"] = []interface{}{map[string]interface{}{
+			"filter": aws.StringValue(v.Filter),
+		}}
+	}
+
+	if v := apiObject.Lambda; v != nil {
+		name, next = v.Name, v.Next
+		tfMap["lambda"] = []interface{}{map[string]interface{}{
+			"batch_size":  aws.Int64Value(v.BatchSize),
+			"lambda_name": aws.StringValue(v.LambdaName),
+		}}
+	}
+
+	if v := apiObject.Math; v != nil {
+		name, next = v.Name, v.Next
+		tfMap["math"] = []interface{}{map[string]interface{}{
+			"attribute": aws.StringValue(v.Attribute),
+			"math":      aws.StringValue(v.Math),
+		}}
+	}
+
+	if v := apiObject.RemoveAttributes; v != nil {
+		name, next = v.Name, v.Next
+		tfMap["remove_attributes"] = []interface{}{map[string]interface{}{
+			"attributes": aws.StringValueSlice(v.Attributes),
+		}}
+	}
+
+	if v := apiObject.SelectAttributes; v != nil {
+		name, next = v.Name, v.Next
+		tfMap["select_attributes"] = []interface{}{map[string]interface{}{
+			"attributes": aws.StringValueSlice(v.Attributes),
+		}}
+	}
+
+	tfMap["name"] = aws.StringValue(name)
+
+	return aws.StringValue(name), aws.StringValue(next), tfMap
+}
@@ -0,0 +1,484 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotanalytics "github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestPipelineActivitiesOrdering(t *testing.T) {
+	t.Parallel()
+
+	tfList := []interface{}{
+		map[string]interface{}{
+			"name": "in",
+			"channel": []interface{}{map[string]interface{}{
+				"channel_name": "test_channel",
+			}},
+		},
+		map[string]interface{}{
+			"name": "hot",
+			"filter": []interface{}{map[string]interface{}{
+				"filter": "temperature > 40",
+			}},
+		},
+		map[string]interface{}{
+			"name": "fahrenheit",
+			"math": []interface{}{map[string]interface{}{
+				"attribute": "temperature_f",
+				"math":      "temperature * 1.8 + 32",
+			}},
+		},
+		map[string]interface{}{
+			"name": "out",
+			"datastore": []interface{}{map[string]interface{}{
+				"datastore_name": "test_datastore",
+			}},
+		},
+	}
+
+	apiObjects := tfiotanalytics.ExpandPipelineActivities(tfList)
+
+	if got, want := len(apiObjects), len(tfList); got != want {
+		t.Fatalf("expanded %d activities, want %d", got, want)
+	}
+
+	if got, want := aws.StringValue(apiObjects[0].Channel.Next), "hot"; got != want {
+		t.Errorf("channel activity next = %q, want %q", got, want)
+	}
+	if got, want := aws.StringValue(apiObjects[1].Filter.Next), "fahrenheit"; got != want {
+		t.Errorf("filter activity next = %q, want %q", got, want)
+	}
+	if got, want := aws.StringValue(apiObjects[2].Math.Next), "out"; got != want {
+		t.Errorf("math activity next = %q, want %q", got, want)
+	}
+
+	// The API does not guarantee activity order.
+	shuffled := []*iotanalytics.PipelineActivity{apiObjects[2], apiObjects[3], apiObjects[0], apiObjects[1]}
+
+	flattened := tfiotanalytics.FlattenPipelineActivities(shuffled)
+
+	if got, want := len(flattened), len(tfList); got != want {
+		t.Fatalf("flattened %d activities, want %d", got, want)
+	}
+
+	for i, want := range []string{"in", "hot", "fahrenheit", "out"} {
+		if got := flattened[i].(map[string]interface{})["name"]; got != want {
+			t.Errorf("activity %d name = %q, want %q", i, got, want)
+		}
+	}
+}
+
+func TestAccIoTAnalyticsPipeline_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Pipeline
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.name", "channel"),
+					resource.TestCheckResourceAttrPair(resourceName, "activity.0.channel.0.channel_name", "aws_iotanalytics_channel.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.name", "datastore"),
+					resource.TestCheckResourceAttrPair(resourceName, "activity.1.datastore.0.datastore_name", "aws_iotanalytics_datastore.test", "name"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "iotanalytics", fmt.Sprintf("pipeline/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsPipeline_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Pipeline
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotanalytics.ResourcePipeline(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsPipeline_activities(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Pipeline
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_activities(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "6"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.name", "channel"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.name", "filter"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.filter.0.filter", "temperature > 40"),
+					resource.TestCheckResourceAttr(resourceName, "activity.2.name", "math"),
+					resource.TestCheckResourceAttr(resourceName, "activity.2.math.0.attribute", "temperature_f"),
+					resource.TestCheckResourceAttr(resourceName, "activity.2.math.0.math", "temperature * 1.8 + 32"),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.name", "add"),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.add_attributes.0.attributes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.add_attributes.0.attributes.temperature", "reading"),
+					resource.TestCheckResourceAttr(resourceName, "activity.4.name", "remove"),
+					resource.TestCheckResourceAttr(resourceName, "activity.4.remove_attributes.0.attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activity.4.remove_attributes.0.attributes.0", "temperature"),
+					resource.TestCheckResourceAttr(resourceName, "activity.5.name", "datastore"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPipelineConfig_activitiesUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.name", "channel"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.name", "select"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.select_attributes.0.attributes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "activity.2.name", "filter"),
+					resource.TestCheckResourceAttr(resourceName, "activity.2.filter.0.filter", "temperature > 50"),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.name", "datastore"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsPipeline_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Pipeline
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotanalytics_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPipelineConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccPipelineConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+func testAccCheckPipelineExists(ctx context.Context, n string, v *iotanalytics.Pipeline) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Analytics Pipeline ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+		output, err := tfiotanalytics.FindPipelineByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPipelineDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotanalytics_pipeline" {
+				continue
+			}
+
+			_, err := tfiotanalytics.FindPipelineByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Analytics Pipeline %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccPipelineConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+}
+
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccPipelineConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    name = "channel"
+
+    channel {
+      channel_name = aws_iotanalytics_channel.test.name
+    }
+  }
+
+  activity {
+    name = "datastore"
+
+    datastore {
+      datastore_name = aws_iotanalytics_datastore.test.name
+    }
+  }
+}
+`, rName))
+}
+
+func testAccPipelineConfig_activities(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    name = "channel"
+
+    channel {
+      channel_name = aws_iotanalytics_channel.test.name
+    }
+  }
+
+  activity {
+    name = "filter"
+
+    filter {
+      filter = "temperature > 40"
+    }
+  }
+
+  activity {
+    name = "math"
+
+    math {
+      attribute = "temperature_f"
+      math      = "temperature * 1.8 + 32"
+    }
+  }
+
+  activity {
+    name = "add"
+
+    add_attributes {
+      attributes = {
+        temperature = "reading"
+      }
+    }
+  }
+
+  activity {
+    name = "remove"
+
+    remove_attributes {
+      attributes = ["temperature"]
+    }
+  }
+
+  activity {
+    name = "datastore"
+
+    datastore {
+      datastore_name = aws_iotanalytics_datastore.test.name
+    }
+  }
+}
+`, rName))
+}
+
+func testAccPipelineConfig_activitiesUpdated(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    name = "channel"
+
+    channel {
+      channel_name = aws_iotanalytics_channel.test.name
+    }
+  }
+
+  activity {
+    name = "select"
+
+    select_attributes {
+      attributes = ["device_id", "temperature"]
+    }
+  }
+
+  activity {
+    name = "filter"
+
+    filter {
+      filter = "temperature > 50"
+    }
+  }
+
+  activity {
+    name = "datastore"
+
+    datastore {
+      datastore_name = aws_iotanalytics_datastore.test.name
+    }
+  }
+}
+`, rName))
+}
+
+func testAccPipelineConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    name = "channel"
+
+    channel {
+      channel_name = aws_iotanalytics_channel.test.name
+    }
+  }
+
+  activity {
+    name = "datastore"
+
+    datastore {
+      datastore_name = aws_iotanalytics_datastore.test.name
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccPipelineConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    name = "channel"
+
+    channel {
+      channel_name = aws_iotanalytics_channel.test.name
+    }
+  }
+
+  activity {
+    name = "datastore"
+
+    datastore {
+      datastore_name = aws_iotanalytics_datastore.test.name
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build sweep
+// +build sweep
+
+package iotanalytics
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_iotanalytics_dataset", &resource.Sweeper{
+		Name: "aws_iotanalytics_dataset",
+		F:    sweepDatasets,
+	})
+
+	resource.AddTestSweepers("aws_iotanalytics_pipeline", &resource.Sweeper{
+		Name: "aws_iotanalytics_pipeline",
+		F:    sweepPipelines,
+	})
+
+	resource.AddTestSweepers("aws_iotanalytics_channel", &resource.Sweeper{
+		Name: "aws_iotanalytics_channel",
+		F:    sweepChannels,
+		Dependencies: []string{
+			"aws_iotanalytics_pipeline",
+		},
+	})
+
+	resource.AddTestSweepers("aws_iotanalytics_datastore", &resource.Sweeper{
+		Name: "aws_iotanalytics_datastore",
+		F:    sweepDatastores,
+		Dependencies: []string{
+			"aws_iotanalytics_dataset",
+			"aws_iotanalytics_pipeline",
+		},
+	})
+}
+
+func sweepDatasets(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.IoTAnalyticsConn(ctx)
+	input := &iotanalytics.ListDatasetsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListDatasetsPagesWithContext(ctx, input, func(page *iotanalytics.ListDatasetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DatasetSummaries {
+			r := ResourceDataset()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.DatasetName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Analytics Dataset sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT Analytics Datasets (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Analytics Datasets (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepPipelines(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.IoTAnalyticsConn(ctx)
+	input := &iotanalytics.ListPipelinesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListPipelinesPagesWithContext(ctx, input, func(page *iotanalytics.ListPipelinesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PipelineSummaries {
+			r := ResourcePipeline()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.PipelineName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Analytics Pipeline sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT Analytics Pipelines (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Analytics Pipelines (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepChannels(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.IoTAnalyticsConn(ctx)
+	input := &iotanalytics.ListChannelsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListChannelsPagesWithContext(ctx, input, func(page *iotanalytics.ListChannelsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ChannelSummaries {
+			r := ResourceChannel()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ChannelName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Analytics Channel sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT Analytics Channels (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Analytics Channels (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDatastores(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.IoTAnalyticsConn(ctx)
+	input := &iotanalytics.ListDatastoresInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListDatastoresPagesWithContext(ctx, input, func(page *iotanalytics.ListDatastoresOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DatastoreSummaries {
+			r := ResourceDatastore()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.DatastoreName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Analytics Datastore sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT Analytics Datastores (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Analytics Datastores (%s): %w", region, err)
+	}
+
+	return nil
+}
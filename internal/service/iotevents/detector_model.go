@@ -0,0 +1,462 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotevents_detector_model", name="Detector Model")
+// @Tags(identifierAttribute="arn")
+func ResourceDetectorModel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDetectorModelCreate,
+		ReadWithoutTimeout:   resourceDetectorModelRead,
+		UpdateWithoutTimeout: resourceDetectorModelUpdate,
+		DeleteWithoutTimeout: resourceDetectorModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"detector_model_definition": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentDetectorModelDefinitionJSONDiffs,
+			},
+			"detector_model_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"detector_model_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"detector_model_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"evaluation_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(iotevents.EvaluationMethod_Values(), false),
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDetectorModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	definition, err := expandDetectorModelDefinition(d.Get("detector_model_definition").(string))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("detector_model_name").(string)
+	input := &iotevents.CreateDetectorModelInput{
+		DetectorModelDefinition: definition,
+		DetectorModelName:       aws.String(name),
+		RoleArn:                 aws.String(d.Get("role_arn").(string)),
+		Tags:                    getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("detector_model_description"); ok {
+		input.DetectorModelDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("evaluation_method"); ok {
+		input.EvaluationMethod = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("key"); ok {
+		input.Key = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateDetectorModelWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating IoT Events Detector Model (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.DetectorModelConfiguration.DetectorModelName))
+
+	if _, err := waitDetectorModelActive(ctx, conn, d.Id(), aws.StringValue(output.DetectorModelConfiguration.DetectorModelVersion), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for IoT Events Detector Model (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceDetectorModelRead(ctx, d, meta)
+}
+
+func resourceDetectorModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	output, err := FindDetectorModelByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Events Detector Model %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IoT Events Detector Model (%s): %s", d.Id(), err)
+	}
+
+	configuration := output.DetectorModelConfiguration
+	d.Set("arn", configuration.DetectorModelArn)
+
+	definition, err := flattenDetectorModelDefinition(output.DetectorModelDefinition)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Keep the configured document if it is semantically equivalent to the one returned by the API.
+	if equivalent, err := equivalentDetectorModelDefinitionJSON(d.Get("detector_model_definition").(string), definition); err != nil || !equivalent {
+		d.Set("detector_model_definition", definition)
+	}
+	d.Set("detector_model_description", configuration.DetectorModelDescription)
+	d.Set("detector_model_name", configuration.DetectorModelName)
+	d.Set("detector_model_version", configuration.DetectorModelVersion)
+	d.Set("evaluation_method", configuration.EvaluationMethod)
+	d.Set("key", configuration.Key)
+	d.Set("role_arn", configuration.RoleArn)
+	d.Set("status", configuration.Status)
+
+	return nil
+}
+
+func resourceDetectorModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		definition, err := expandDetectorModelDefinition(d.Get("detector_model_definition").(string))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// Each update creates a new version of the detector model.
+		input := &iotevents.UpdateDetectorModelInput{
+			DetectorModelDefinition:  definition,
+			DetectorModelDescription: aws.String(d.Get("detector_model_description").(string)),
+			DetectorModelName:        aws.String(d.Id()),
+			RoleArn:                  aws.String(d.Get("role_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("evaluation_method"); ok {
+			input.EvaluationMethod = aws.String(v.(string))
+		}
+
+		output, err := conn.UpdateDetectorModelWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating IoT Events Detector Model (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDetectorModelActive(ctx, conn, d.Id(), aws.StringValue(output.DetectorModelConfiguration.DetectorModelVersion), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for IoT Events Detector Model (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDetectorModelRead(ctx, d, meta)
+}
+
+func resourceDetectorModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	log.Printf("[INFO] Deleting IoT Events Detector Model: %s", d.Id())
+	_, err := conn.DeleteDetectorModelWithContext(ctx, &iotevents.DeleteDetectorModelInput{
+		DetectorModelName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting IoT Events Detector Model (%s): %s", d.Id(), err)
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return FindDetectorModelByName(ctx, conn, d.Id())
+	})
+
+	if err != nil {
+		return diag.Errorf("waiting for IoT Events Detector Model (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// FindDetectorModelByName returns the latest version of the named detector model.
+func FindDetectorModelByName(ctx context.Context, conn *iotevents.IoTEvents, name string) (*iotevents.DetectorModel, error) {
+	return findDetectorModel(ctx, conn, &iotevents.DescribeDetectorModelInput{
+		DetectorModelName: aws.String(name),
+	})
+}
+
+func findDetectorModelByTwoPartKey(ctx context.Context, conn *iotevents.IoTEvents, name, version string) (*iotevents.DetectorModel, error) {
+	input := &iotevents.DescribeDetectorModelInput{
+		DetectorModelName: aws.String(name),
+	}
+
+	if version != "" {
+		input.DetectorModelVersion = aws.String(version)
+	}
+
+	return findDetectorModel(ctx, conn, input)
+}
+
+func findDetectorModel(ctx context.Context, conn *iotevents.IoTEvents, input *iotevents.DescribeDetectorModelInput) (*iotevents.DetectorModel, error) {
+	output, err := conn.DescribeDetectorModelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DetectorModel == nil || output.DetectorModel.DetectorModelConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DetectorModel, nil
+}
+
+func statusDetectorModel(ctx context.Context, conn *iotevents.IoTEvents, name, version string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDetectorModelByTwoPartKey(ctx, conn, name, version)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DetectorModelConfiguration.Status), nil
+	}
+}
+
+func waitDetectorModelActive(ctx context.Context, conn *iotevents.IoTEvents, name, version string, timeout time.Duration) (*iotevents.DetectorModel, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotevents.DetectorModelVersionStatusActivating},
+		Target:  []string{iotevents.DetectorModelVersionStatusActive},
+		Refresh: statusDetectorModel(ctx, conn, name, version),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.DetectorModel); ok {
+		if status := aws.StringValue(output.DetectorModelConfiguration.Status); status == iotevents.DetectorModelVersionStatusFailed {
+			tfresource.SetLastError(err, errors.New(status))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandDetectorModelDefinition(s string) (*iotevents.DetectorModelDefinition, error) {
+	var apiObject iotevents.DetectorModelDefinition
+
+	if err := jsonutil.UnmarshalJSON(&apiObject, strings.NewReader(s)); err != nil {
+		return nil, fmt.Errorf("decoding detector model definition JSON: %w", err)
+	}
+
+	return &apiObject, nil
+}
+
+func flattenDetectorModelDefinition(apiObject *iotevents.DetectorModelDefinition) (string, error) {
+	if apiObject == nil {
+		return "", nil
+	}
+
+	b, err := jsonutil.BuildJSON(apiObject)
+
+	if err != nil {
+		return "", fmt.Errorf("encoding detector model definition JSON: %w", err)
+	}
+
+	return string(b), nil
+}
+
+func suppressEquivalentDetectorModelDefinitionJSONDiffs(k, old, new string, d *schema.ResourceData) bool {
+	equivalent, err := equivalentDetectorModelDefinitionJSON(old, new)
+
+	if err != nil {
+		return false
+	}
+
+	return equivalent
+}
+
+// equivalentDetectorModelDefinitionJSON compares two detector model definitions in their canonical API form.
+// Key order, whitespace, empty lists and objects and the order of states are not significant.
+func equivalentDetectorModelDefinitionJSON(str1, str2 string) (bool, error) {
+	if strings.TrimSpace(str1) == "" {
+		str1 = "{}"
+	}
+
+	if strings.TrimSpace(str2) == "" {
+		str2 = "{}"
+	}
+
+	canonicalJSON1, err := canonicalDetectorModelDefinitionJSON(str1)
+
+	if err != nil {
+		return false, err
+	}
+
+	canonicalJSON2, err := canonicalDetectorModelDefinitionJSON(str2)
+
+	if err != nil {
+		return false, err
+	}
+
+	equal := bytes.Equal(canonicalJSON1, canonicalJSON2)
+
+	if !equal {
+		log.Printf("[DEBUG] Canonical Detector Model Definition JSONs are not equal.\nFirst: %s\nSecond: %s\n", canonicalJSON1, canonicalJSON2)
+	}
+
+	return equal, nil
+}
+
+func canonicalDetectorModelDefinitionJSON(s string) ([]byte, error) {
+	apiObject, err := expandDetectorModelDefinition(s)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stateName := func(v *iotevents.State) string {
+		if v == nil {
+			return ""
+		}
+
+		return aws.StringValue(v.StateName)
+	}
+
+	sort.SliceStable(apiObject.States, func(i, j int) bool {
+		return stateName(apiObject.States[i]) < stateName(apiObject.States[j])
+	})
+
+	b, err := jsonutil.BuildJSON(apiObject)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var v interface{}
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+
+	v, _ = removeEmptyJSONValues(v)
+
+	return json.Marshal(v)
+}
+
+// removeEmptyJSONValues recursively removes empty lists and objects from a decoded JSON value.
+// The second return value reports whether the value itself is empty.
+func removeEmptyJSONValues(v interface{}) (interface{}, bool) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if e, empty := removeEmptyJSONValues(e); empty {
+				delete(v, k)
+			} else {
+				v[k] = e
+			}
+		}
+
+		return v, len(v) == 0
+	case []interface{}:
+		s := make([]interface{}, 0, len(v))
+
+		for _, e := range v {
+			if e, empty := removeEmptyJSONValues(e); !empty {
+				s = append(s, e)
+			}
+		}
+
+		return s, len(s) == 0
+	default:
+		return v, false
+	}
+}
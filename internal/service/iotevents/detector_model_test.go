@@ -0,0 +1,393 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotevents "github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestEquivalentDetectorModelDefinitionJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name       string
+		Config     string
+		API        string
+		Equivalent bool
+	}{
+		{
+			Name:       "empty",
+			Config:     ``,
+			API:        `{}`,
+			Equivalent: true,
+		},
+		{
+			Name: "reordered keys and whitespace",
+			Config: `{
+  "initialStateName": "Normal",
+  "states": [{"stateName": "Normal", "onInput": {"events": [{"eventName": "check", "condition": "true"}]}}]
+}`,
+			API:        `{"states":[{"onInput":{"events":[{"condition":"true","eventName":"check"}]},"stateName":"Normal"}],"initialStateName":"Normal"}`,
+			Equivalent: true,
+		},
+		{
+			Name: "empty lists and objects",
+			Config: `{
+  "initialStateName": "Normal",
+  "states": [{"stateName": "Normal", "onEnter": {"events": []}, "onInput": {"events": [], "transitionEvents": []}}]
+}`,
+			API:        `{"initialStateName":"Normal","states":[{"stateName":"Normal"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name: "reordered states",
+			Config: `{
+  "initialStateName": "Normal",
+  "states": [{"stateName": "Normal"}, {"stateName": "Alarm"}]
+}`,
+			API:        `{"initialStateName":"Normal","states":[{"stateName":"Alarm"},{"stateName":"Normal"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name: "reordered events",
+			Config: `{
+  "initialStateName": "Normal",
+  "states": [{"stateName": "Normal", "onEnter": {"events": [{"eventName": "a"}, {"eventName": "b"}]}}]
+}`,
+			API:        `{"initialStateName":"Normal","states":[{"stateName":"Normal","onEnter":{"events":[{"eventName":"b"},{"eventName":"a"}]}}]}`,
+			Equivalent: false,
+		},
+		{
+			Name: "different initial state",
+			Config: `{
+  "initialStateName": "Alarm",
+  "states": [{"stateName": "Normal"}, {"stateName": "Alarm"}]
+}`,
+			API:        `{"initialStateName":"Normal","states":[{"stateName":"Normal"},{"stateName":"Alarm"}]}`,
+			Equivalent: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			equivalent, err := tfiotevents.EquivalentDetectorModelDefinitionJSON(testCase.Config, testCase.API)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := equivalent, testCase.Equivalent; got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestAccIoTEventsDetectorModel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DetectorModel
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotevents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_basic(rName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "iotevents", fmt.Sprintf("detectorModel/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "detector_model_definition"),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_method", "BATCH"),
+					resource.TestCheckResourceAttr(resourceName, "key", "sensorId"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsDetectorModel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DetectorModel
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotevents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_basic(rName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotevents.ResourceDetectorModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsDetectorModel_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DetectorModel
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotevents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_basic(rName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_version", "1"),
+				),
+			},
+			{
+				Config: testAccDetectorModelConfig_basic(rName, 40),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsDetectorModel_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DetectorModel
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotevents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccDetectorModelConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccDetectorModelConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDetectorModelExists(ctx context.Context, n string, v *iotevents.DetectorModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Events Detector Model ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn(ctx)
+
+		output, err := tfiotevents.FindDetectorModelByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDetectorModelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotevents_detector_model" {
+				continue
+			}
+
+			_, err := tfiotevents.FindDetectorModelByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Events Detector Model %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccDetectorModelConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "iotevents.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iotevents_input" "test" {
+  input_name = %[1]q
+
+  input_definition {
+    attribute {
+      json_path = "sensorId"
+    }
+
+    attribute {
+      json_path = "temperature"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccDetectorModelDefinition(rName string, threshold int) string {
+	return fmt.Sprintf(`
+  detector_model_definition = jsonencode({
+    initialStateName = "Normal"
+    states = [
+      {
+        stateName = "Normal"
+        onInput = {
+          events = []
+          transitionEvents = [{
+            eventName = "TooHot"
+            condition = "$input.%[1]s.temperature > %[2]d"
+            actions   = []
+            nextState = "Alarm"
+          }]
+        }
+      },
+      {
+        stateName = "Alarm"
+        onInput = {
+          events = []
+          transitionEvents = [{
+            eventName = "CooledDown"
+            condition = "$input.%[1]s.temperature <= %[2]d"
+            actions   = []
+            nextState = "Normal"
+          }]
+        }
+      },
+    ]
+  })
+`, rName, threshold)
+}
+
+func testAccDetectorModelConfig_basic(rName string, threshold int) string {
+	return acctest.ConfigCompose(testAccDetectorModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  detector_model_name = %[1]q
+  key                 = "sensorId"
+  role_arn            = aws_iam_role.test.arn
+%[2]s
+}
+`, rName, testAccDetectorModelDefinition(rName, threshold)))
+}
+
+func testAccDetectorModelConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccDetectorModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  detector_model_name = %[1]q
+  key                 = "sensorId"
+  role_arn            = aws_iam_role.test.arn
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, testAccDetectorModelDefinition(rName, 30), tagKey1, tagValue1))
+}
+
+func testAccDetectorModelConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccDetectorModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  detector_model_name = %[1]q
+  key                 = "sensorId"
+  role_arn            = aws_iam_role.test.arn
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, testAccDetectorModelDefinition(rName, 30), tagKey1, tagValue1, tagKey2, tagValue2))
+}
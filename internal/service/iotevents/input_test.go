@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotevents "github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTEventsInput_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.Input
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_input.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotevents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInputDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInputConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "iotevents", fmt.Sprintf("input/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "input_definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_definition.0.attribute.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_definition.0.attribute.0.json_path", "temperature"),
+					resource.TestCheckResourceAttr(resourceName, "input_description", ""),
+					resource.TestCheckResourceAttr(resourceName, "input_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsInput_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.Input
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_input.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotevents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInputDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInputConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotevents.ResourceInput(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsInput_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.Input
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_input.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotevents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInputDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInputConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "input_definition.0.attribute.#", "1"),
+				),
+			},
+			{
+				Config: testAccInputConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "input_definition.0.attribute.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "input_definition.0.attribute.0.json_path", "temperature"),
+					resource.TestCheckResourceAttr(resourceName, "input_definition.0.attribute.1.json_path", "sensor.id"),
+					resource.TestCheckResourceAttr(resourceName, "input_description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsInput_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.Input
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_iotevents_input.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotevents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInputDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInputConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInputConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccInputConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInputExists(ctx context.Context, n string, v *iotevents.Input) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Events Input ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn(ctx)
+
+		output, err := tfiotevents.FindInputByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckInputDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotevents_input" {
+				continue
+			}
+
+			_, err := tfiotevents.FindInputByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Events Input %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccInputConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotevents_input" "test" {
+  input_name = %[1]q
+
+  input_definition {
+    attribute {
+      json_path = "temperature"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccInputConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotevents_input" "test" {
+  input_name        = %[1]q
+  input_description = "updated"
+
+  input_definition {
+    attribute {
+      json_path = "temperature"
+    }
+
+    attribute {
+      json_path = "sensor.id"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccInputConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotevents_input" "test" {
+  input_name = %[1]q
+
+  input_definition {
+    attribute {
+      json_path = "temperature"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccInputConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotevents_input" "test" {
+  input_name = %[1]q
+
+  input_definition {
+    attribute {
+      json_path = "temperature"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
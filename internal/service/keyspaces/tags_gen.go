@@ -24,13 +24,20 @@ func listTags(ctx context.Context, conn *keyspaces.Client, identifier string) (t
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(ctx, input)
+	var output []awstypes.Tag
 
-	if err != nil {
-		return tftags.New(ctx, nil), err
+	pages := keyspaces.NewListTagsForResourcePaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return tftags.New(ctx, nil), err
+		}
+
+		output = append(output, page.Tags...)
 	}
 
-	return KeyValueTags(ctx, output.Tags), nil
+	return KeyValueTags(ctx, output), nil
 }
 
 // ListTags lists keyspaces service tags and set them in Context.
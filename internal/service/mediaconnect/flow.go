@@ -0,0 +1,1006 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_mediaconnect_flow", name="Flow")
+// @Tags(identifierAttribute="arn")
+func ResourceFlow() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFlowCreate,
+		ReadWithoutTimeout:   resourceFlowRead,
+		UpdateWithoutTimeout: resourceFlowUpdate,
+		DeleteWithoutTimeout: resourceFlowDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"egress_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"entitlement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_transfer_subscriber_fee_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"encryption": encryptionSchema(),
+						"entitlement_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"entitlement_status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.EntitlementStatus_Values(), false),
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"subscribers": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidAccountID,
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// Outputs can also be managed with the aws_mediaconnect_flow_output resource.
+			"output": {
+				Type:       schema.TypeList,
+				Optional:   true,
+				Computed:   true,
+				ConfigMode: schema.SchemaConfigModeAttr,
+				MaxItems:   50,
+				Elem: &schema.Resource{
+					Schema: outputSchema(),
+				},
+			},
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: sourceSchema(),
+				},
+			},
+			"source_failover_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failover_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.FailoverMode_Values(), false),
+						},
+						"recovery_window": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"source_priority": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"primary_source": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"state": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.State_Values(), false),
+						},
+					},
+				},
+			},
+			"start_flow": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"vpc_interface": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"network_interface_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"network_interface_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.NetworkInterfaceType_Values(), false),
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFlowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &mediaconnect.CreateFlowInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		input.AvailabilityZone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("entitlement"); ok && len(v.([]interface{})) > 0 {
+		input.Entitlements = expandGrantEntitlementRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("output"); ok && len(v.([]interface{})) > 0 {
+		for _, tfMapRaw := range v.([]interface{}) {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				input.Outputs = append(input.Outputs, expandAddOutputRequest(tfMap))
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("source"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Source = expandSetSourceRequest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("source_failover_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SourceFailoverConfig = expandFailoverConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("vpc_interface"); ok && len(v.([]interface{})) > 0 {
+		input.VpcInterfaces = expandVPCInterfaceRequests(v.([]interface{}))
+	}
+
+	output, err := conn.CreateFlowWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating MediaConnect Flow (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Flow.FlowArn))
+
+	if _, err := waitFlowStandby(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for MediaConnect Flow (%s) create: %s", d.Id(), err)
+	}
+
+	if err := createTags(ctx, conn, d.Id(), getTagsIn(ctx)); err != nil {
+		return diag.Errorf("setting MediaConnect Flow (%s) tags: %s", d.Id(), err)
+	}
+
+	if d.Get("start_flow").(bool) {
+		if err := startFlow(ctx, conn, d.Timeout(schema.TimeoutCreate), d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceFlowRead(ctx, d, meta)
+}
+
+func resourceFlowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	flow, err := FindFlowByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaConnect Flow %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading MediaConnect Flow (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", flow.FlowArn)
+	d.Set("availability_zone", flow.AvailabilityZone)
+	d.Set("egress_ip", flow.EgressIp)
+	if err := d.Set("entitlement", orderByName(flattenEntitlements(flow.Entitlements), d.Get("entitlement").([]interface{}))); err != nil {
+		return diag.Errorf("setting entitlement: %s", err)
+	}
+	d.Set("name", flow.Name)
+	if err := d.Set("output", orderByName(flattenOutputs(flow.Outputs), d.Get("output").([]interface{}))); err != nil {
+		return diag.Errorf("setting output: %s", err)
+	}
+	if err := d.Set("source", flattenPrimarySource(flow, d.Get("source").([]interface{}))); err != nil {
+		return diag.Errorf("setting source: %s", err)
+	}
+	if err := d.Set("source_failover_config", flattenFailoverConfig(flow.SourceFailoverConfig)); err != nil {
+		return diag.Errorf("setting source_failover_config: %s", err)
+	}
+	d.Set("start_flow", aws.StringValue(flow.Status) == mediaconnect.StatusActive)
+	d.Set("status", flow.Status)
+	if err := d.Set("vpc_interface", orderByName(flattenVPCInterfaces(flow.VpcInterfaces), d.Get("vpc_interface").([]interface{}))); err != nil {
+		return diag.Errorf("setting vpc_interface: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFlowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	// VPC interfaces can only be added to or removed from a flow that is not running.
+	restart := false
+	if d.HasChange("vpc_interface") {
+		flow, err := FindFlowByARN(ctx, conn, d.Id())
+
+		if err != nil {
+			return diag.Errorf("reading MediaConnect Flow (%s): %s", d.Id(), err)
+		}
+
+		if aws.StringValue(flow.Status) == mediaconnect.StatusActive {
+			if err := stopFlow(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id()); err != nil {
+				return diag.FromErr(err)
+			}
+
+			restart = true
+		}
+
+		o, n := d.GetChange("vpc_interface")
+		add, del := diffNamedBlocks(o.([]interface{}), n.([]interface{}))
+
+		// Replaced interfaces are removed and then added again.
+		for _, tfMap := range add {
+			if _, ok := namedBlocks(o.([]interface{}))[tfMap["name"].(string)]; ok {
+				del = append(del, tfMap)
+			}
+		}
+
+		if err := removeFlowVPCInterfaces(ctx, conn, d.Id(), del); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if _, err := waitFlowUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for MediaConnect Flow (%s) update: %s", d.Id(), err)
+		}
+
+		if err := addFlowVPCInterfaces(ctx, conn, d.Id(), add); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("source") {
+		tfMap := d.Get("source").([]interface{})[0].(map[string]interface{})
+		input := expandUpdateFlowSourceInput(d.Id(), tfMap["source_arn"].(string), tfMap)
+
+		_, err := conn.UpdateFlowSourceWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating MediaConnect Flow (%s) source: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("source_failover_config") {
+		input := &mediaconnect.UpdateFlowInput{
+			FlowArn: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("source_failover_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			apiObject := expandFailoverConfig(v.([]interface{})[0].(map[string]interface{}))
+			input.SourceFailoverConfig = &mediaconnect.UpdateFailoverConfig{
+				FailoverMode:   apiObject.FailoverMode,
+				RecoveryWindow: apiObject.RecoveryWindow,
+				SourcePriority: apiObject.SourcePriority,
+				State:          apiObject.State,
+			}
+		}
+
+		_, err := conn.UpdateFlowWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating MediaConnect Flow (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("output") {
+		o, n := d.GetChange("output")
+		oldOutputs := namedBlocks(o.([]interface{}))
+		add, del := diffNamedBlocks(o.([]interface{}), n.([]interface{}))
+
+		for _, tfMap := range del {
+			outputARN := tfMap["output_arn"].(string)
+			_, err := conn.RemoveFlowOutputWithContext(ctx, &mediaconnect.RemoveFlowOutputInput{
+				FlowArn:   aws.String(d.Id()),
+				OutputArn: aws.String(outputARN),
+			})
+
+			if err != nil && !tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+				return diag.Errorf("removing MediaConnect Flow (%s) output (%s): %s", d.Id(), outputARN, err)
+			}
+		}
+
+		input := &mediaconnect.AddFlowOutputsInput{
+			FlowArn: aws.String(d.Id()),
+		}
+
+		for _, tfMap := range add {
+			if old, ok := oldOutputs[tfMap["name"].(string)]; ok {
+				_, err := conn.UpdateFlowOutputWithContext(ctx, expandUpdateFlowOutputInput(d.Id(), old["output_arn"].(string), tfMap))
+
+				if err != nil {
+					return diag.Errorf("updating MediaConnect Flow (%s) output (%s): %s", d.Id(), old["output_arn"], err)
+				}
+
+				continue
+			}
+
+			input.Outputs = append(input.Outputs, expandAddOutputRequest(tfMap))
+		}
+
+		if len(input.Outputs) > 0 {
+			_, err := conn.AddFlowOutputsWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("adding MediaConnect Flow (%s) outputs: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("entitlement") {
+		o, n := d.GetChange("entitlement")
+		oldEntitlements := namedBlocks(o.([]interface{}))
+		add, del := diffNamedBlocks(o.([]interface{}), n.([]interface{}))
+
+		for _, tfMap := range del {
+			entitlementARN := tfMap["entitlement_arn"].(string)
+			_, err := conn.RevokeFlowEntitlementWithContext(ctx, &mediaconnect.RevokeFlowEntitlementInput{
+				EntitlementArn: aws.String(entitlementARN),
+				FlowArn:        aws.String(d.Id()),
+			})
+
+			if err != nil && !tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+				return diag.Errorf("revoking MediaConnect Flow (%s) entitlement (%s): %s", d.Id(), entitlementARN, err)
+			}
+		}
+
+		var grant []interface{}
+
+		for _, tfMap := range add {
+			if old, ok := oldEntitlements[tfMap["name"].(string)]; ok {
+				input := &mediaconnect.UpdateFlowEntitlementInput{
+					Encryption:     expandUpdateEncryption(tfMap["encryption"].([]interface{})),
+					EntitlementArn: aws.String(old["entitlement_arn"].(string)),
+					FlowArn:        aws.String(d.Id()),
+					Subscribers:    flex.ExpandStringSet(tfMap["subscribers"].(*schema.Set)),
+				}
+
+				if v, ok := tfMap["description"].(string); ok {
+					input.Description = aws.String(v)
+				}
+
+				if v, ok := tfMap["entitlement_status"].(string); ok && v != "" {
+					input.EntitlementStatus = aws.String(v)
+				}
+
+				_, err := conn.UpdateFlowEntitlementWithContext(ctx, input)
+
+				if err != nil {
+					return diag.Errorf("updating MediaConnect Flow (%s) entitlement (%s): %s", d.Id(), old["entitlement_arn"], err)
+				}
+
+				continue
+			}
+
+			grant = append(grant, tfMap)
+		}
+
+		if len(grant) > 0 {
+			_, err := conn.GrantFlowEntitlementsWithContext(ctx, &mediaconnect.GrantFlowEntitlementsInput{
+				Entitlements: expandGrantEntitlementRequests(grant),
+				FlowArn:      aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return diag.Errorf("granting MediaConnect Flow (%s) entitlements: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChangesExcept("tags", "tags_all", "start_flow") {
+		if _, err := waitFlowUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for MediaConnect Flow (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("start_flow") || restart {
+		flow, err := FindFlowByARN(ctx, conn, d.Id())
+
+		if err != nil {
+			return diag.Errorf("reading MediaConnect Flow (%s): %s", d.Id(), err)
+		}
+
+		switch d.Get("start_flow").(bool) {
+		case true:
+			if aws.StringValue(flow.Status) == mediaconnect.StatusStandby {
+				if err := startFlow(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id()); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		default:
+			if aws.StringValue(flow.Status) == mediaconnect.StatusActive {
+				if err := stopFlow(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id()); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+	}
+
+	return resourceFlowRead(ctx, d, meta)
+}
+
+func resourceFlowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	flow, err := FindFlowByARN(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading MediaConnect Flow (%s): %s", d.Id(), err)
+	}
+
+	if aws.StringValue(flow.Status) == mediaconnect.StatusActive {
+		if err := stopFlow(ctx, conn, d.Timeout(schema.TimeoutDelete), d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[INFO] Deleting MediaConnect Flow: %s", d.Id())
+	_, err = conn.DeleteFlowWithContext(ctx, &mediaconnect.DeleteFlowInput{
+		FlowArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting MediaConnect Flow (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFlowDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for MediaConnect Flow (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func startFlow(ctx context.Context, conn *mediaconnect.MediaConnect, timeout time.Duration, arn string) error {
+	_, err := conn.StartFlowWithContext(ctx, &mediaconnect.StartFlowInput{
+		FlowArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return fmt.Errorf("starting MediaConnect Flow (%s): %w", arn, err)
+	}
+
+	if _, err := waitFlowActive(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for MediaConnect Flow (%s) start: %w", arn, err)
+	}
+
+	return nil
+}
+
+func stopFlow(ctx context.Context, conn *mediaconnect.MediaConnect, timeout time.Duration, arn string) error {
+	_, err := conn.StopFlowWithContext(ctx, &mediaconnect.StopFlowInput{
+		FlowArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return fmt.Errorf("stopping MediaConnect Flow (%s): %w", arn, err)
+	}
+
+	if _, err := waitFlowStandby(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for MediaConnect Flow (%s) stop: %w", arn, err)
+	}
+
+	return nil
+}
+
+func removeFlowVPCInterfaces(ctx context.Context, conn *mediaconnect.MediaConnect, arn string, tfList []map[string]interface{}) error {
+	for _, tfMap := range tfList {
+		name := tfMap["name"].(string)
+		_, err := conn.RemoveFlowVpcInterfaceWithContext(ctx, &mediaconnect.RemoveFlowVpcInterfaceInput{
+			FlowArn:          aws.String(arn),
+			VpcInterfaceName: aws.String(name),
+		})
+
+		if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("removing MediaConnect Flow (%s) VPC interface (%s): %w", arn, name, err)
+		}
+	}
+
+	return nil
+}
+
+func addFlowVPCInterfaces(ctx context.Context, conn *mediaconnect.MediaConnect, arn string, tfList []map[string]interface{}) error {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	tfListRaw := make([]interface{}, 0, len(tfList))
+	for _, tfMap := range tfList {
+		tfListRaw = append(tfListRaw, tfMap)
+	}
+
+	_, err := conn.AddFlowVpcInterfacesWithContext(ctx, &mediaconnect.AddFlowVpcInterfacesInput{
+		FlowArn:       aws.String(arn),
+		VpcInterfaces: expandVPCInterfaceRequests(tfListRaw),
+	})
+
+	if err != nil {
+		return fmt.Errorf("adding MediaConnect Flow (%s) VPC interfaces: %w", arn, err)
+	}
+
+	return nil
+}
+
+func FindFlowByARN(ctx context.Context, conn *mediaconnect.MediaConnect, arn string) (*mediaconnect.Flow, error) {
+	input := &mediaconnect.DescribeFlowInput{
+		FlowArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeFlowWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Flow == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Flow, nil
+}
+
+func statusFlow(ctx context.Context, conn *mediaconnect.MediaConnect, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFlowByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitFlowStandby(ctx context.Context, conn *mediaconnect.MediaConnect, arn string, timeout time.Duration) (*mediaconnect.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{mediaconnect.StatusStopping, mediaconnect.StatusUpdating},
+		Target:  []string{mediaconnect.StatusStandby},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowActive(ctx context.Context, conn *mediaconnect.MediaConnect, arn string, timeout time.Duration) (*mediaconnect.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{mediaconnect.StatusStandby, mediaconnect.StatusStarting, mediaconnect.StatusUpdating},
+		Target:  []string{mediaconnect.StatusActive},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowUpdated(ctx context.Context, conn *mediaconnect.MediaConnect, arn string, timeout time.Duration) (*mediaconnect.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{mediaconnect.StatusUpdating},
+		Target:  []string{mediaconnect.StatusStandby, mediaconnect.StatusActive},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowDeleted(ctx context.Context, conn *mediaconnect.MediaConnect, arn string, timeout time.Duration) (*mediaconnect.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{mediaconnect.StatusDeleting, mediaconnect.StatusStandby},
+		Target:  []string{},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// namedBlocks indexes configuration blocks by their "name" argument.
+func namedBlocks(tfList []interface{}) map[string]map[string]interface{} {
+	m := make(map[string]map[string]interface{}, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			m[tfMap["name"].(string)] = tfMap
+		}
+	}
+
+	return m
+}
+
+// diffNamedBlocks returns the blocks that are new or changed and the blocks that were removed.
+func diffNamedBlocks(o, n []interface{}) ([]map[string]interface{}, []map[string]interface{}) {
+	oldBlocks, newBlocks := namedBlocks(o), namedBlocks(n)
+	var add, del []map[string]interface{}
+
+	for name, tfMap := range newBlocks {
+		if old, ok := oldBlocks[name]; !ok || !reflect.DeepEqual(old, tfMap) {
+			add = append(add, tfMap)
+		}
+	}
+
+	for name, tfMap := range oldBlocks {
+		if _, ok := newBlocks[name]; !ok {
+			del = append(del, tfMap)
+		}
+	}
+
+	return add, del
+}
+
+// orderByName orders flattened blocks to match the configured order of names,
+// appending any blocks not present in configuration.
+func orderByName(tfList []interface{}, configured []interface{}) []interface{} {
+	byName := namedBlocks(tfList)
+	ordered := make([]interface{}, 0, len(tfList))
+	seen := make(map[string]bool, len(tfList))
+
+	for _, tfMapRaw := range configured {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+
+		if v, ok := byName[name]; ok && !seen[name] {
+			ordered = append(ordered, v)
+			seen[name] = true
+		}
+	}
+
+	for _, tfMapRaw := range tfList {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok && !seen[tfMap["name"].(string)] {
+			ordered = append(ordered, tfMap)
+		}
+	}
+
+	return ordered
+}
+
+// flattenPrimarySource returns the flow's source that is managed by the flow resource.
+// Additional sources may be managed with the aws_mediaconnect_flow_source resource.
+func flattenPrimarySource(flow *mediaconnect.Flow, configured []interface{}) []interface{} {
+	source := flow.Source
+
+	if len(configured) > 0 && configured[0] != nil {
+		name := configured[0].(map[string]interface{})["name"].(string)
+
+		for _, v := range flow.Sources {
+			if v != nil && aws.StringValue(v.Name) == name {
+				source = v
+				break
+			}
+		}
+	}
+
+	if source == nil {
+		return nil
+	}
+
+	return []interface{}{flattenSource(source)}
+}
+
+func flattenOutputs(apiObjects []*mediaconnect.Output) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenOutput(apiObject))
+	}
+
+	return tfList
+}
+
+func expandGrantEntitlementRequests(tfList []interface{}) []*mediaconnect.GrantEntitlementRequest {
+	var apiObjects []*mediaconnect.GrantEntitlementRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mediaconnect.GrantEntitlementRequest{
+			Encryption:  expandEncryption(tfMap["encryption"].([]interface{})),
+			Name:        aws.String(tfMap["name"].(string)),
+			Subscribers: flex.ExpandStringSet(tfMap["subscribers"].(*schema.Set)),
+		}
+
+		if v, ok := tfMap["data_transfer_subscriber_fee_percent"].(int); ok && v != 0 {
+			apiObject.DataTransferSubscriberFeePercent = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap["entitlement_status"].(string); ok && v != "" {
+			apiObject.EntitlementStatus = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenEntitlements(apiObjects []*mediaconnect.Entitlement) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"data_transfer_subscriber_fee_percent": aws.Int64Value(apiObject.DataTransferSubscriberFeePercent),
+			"description":                          aws.StringValue(apiObject.Description),
+			"encryption":                           flattenEncryption(apiObject.Encryption),
+			"entitlement_arn":                      aws.StringValue(apiObject.EntitlementArn),
+			"entitlement_status":                   aws.StringValue(apiObject.EntitlementStatus),
+			"name":                                 aws.StringValue(apiObject.Name),
+			"subscribers":                          aws.StringValueSlice(apiObject.Subscribers),
+		})
+	}
+
+	return tfList
+}
+
+func expandVPCInterfaceRequests(tfList []interface{}) []*mediaconnect.VpcInterfaceRequest {
+	var apiObjects []*mediaconnect.VpcInterfaceRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mediaconnect.VpcInterfaceRequest{
+			Name:             aws.String(tfMap["name"].(string)),
+			RoleArn:          aws.String(tfMap["role_arn"].(string)),
+			SecurityGroupIds: flex.ExpandStringSet(tfMap["security_group_ids"].(*schema.Set)),
+			SubnetId:         aws.String(tfMap["subnet_id"].(string)),
+		}
+
+		if v, ok := tfMap["network_interface_type"].(string); ok && v != "" {
+			apiObject.NetworkInterfaceType = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenVPCInterfaces(apiObjects []*mediaconnect.VpcInterface) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":                   aws.StringValue(apiObject.Name),
+			"network_interface_ids":  aws.StringValueSlice(apiObject.NetworkInterfaceIds),
+			"network_interface_type": aws.StringValue(apiObject.NetworkInterfaceType),
+			"role_arn":               aws.StringValue(apiObject.RoleArn),
+			"security_group_ids":     aws.StringValueSlice(apiObject.SecurityGroupIds),
+			"subnet_id":              aws.StringValue(apiObject.SubnetId),
+		})
+	}
+
+	return tfList
+}
+
+func expandFailoverConfig(tfMap map[string]interface{}) *mediaconnect.FailoverConfig {
+	apiObject := &mediaconnect.FailoverConfig{}
+
+	if v, ok := tfMap["failover_mode"].(string); ok && v != "" {
+		apiObject.FailoverMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["recovery_window"].(int); ok && v != 0 {
+		apiObject.RecoveryWindow = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["source_priority"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SourcePriority = &mediaconnect.SourcePriority{
+			PrimarySource: aws.String(v[0].(map[string]interface{})["primary_source"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["state"].(string); ok && v != "" {
+		apiObject.State = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenFailoverConfig(apiObject *mediaconnect.FailoverConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"failover_mode":   aws.StringValue(apiObject.FailoverMode),
+		"recovery_window": aws.Int64Value(apiObject.RecoveryWindow),
+		"state":           aws.StringValue(apiObject.State),
+	}
+
+	if v := apiObject.SourcePriority; v != nil && aws.StringValue(v.PrimarySource) != "" {
+		tfMap["source_priority"] = []interface{}{map[string]interface{}{
+			"primary_source": aws.StringValue(v.PrimarySource),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconnect "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMediaConnectFlowOutput_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediaconnect.Output
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow_output.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowOutputDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowOutputConfig_basic(rName, 5000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowOutputExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "destination", "192.0.2.10"),
+					resource.TestCheckResourceAttrPair(resourceName, "flow_arn", "aws_mediaconnect_flow.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "output_arn"),
+					resource.TestCheckResourceAttr(resourceName, "port", "5000"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", mediaconnect.ProtocolRtp),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowOutputConfig_basic(rName, 5010),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowOutputExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "port", "5010"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlowOutput_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediaconnect.Output
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow_output.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowOutputDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowOutputConfig_basic(rName, 5000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowOutputExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediaconnect.ResourceFlowOutput(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFlowOutputExists(ctx context.Context, n string, v *mediaconnect.Output) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaConnect Flow Output ID is set")
+		}
+
+		flowARN, outputARN, err := tfmediaconnect.FlowOutputParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectConn(ctx)
+
+		output, err := tfmediaconnect.FindFlowOutputByTwoPartKey(ctx, conn, flowARN, outputARN)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckFlowOutputDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediaconnect_flow_output" {
+				continue
+			}
+
+			flowARN, outputARN, err := tfmediaconnect.FlowOutputParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfmediaconnect.FindFlowOutputByTwoPartKey(ctx, conn, flowARN, outputARN)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaConnect Flow Output %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccFlowOutputConfig_basic(rName string, port int) string {
+	return acctest.ConfigCompose(testAccFlowConfig_basic(rName), fmt.Sprintf(`
+resource "aws_mediaconnect_flow_output" "test" {
+  flow_arn    = aws_mediaconnect_flow.test.arn
+  name        = %[1]q
+  protocol    = "rtp"
+  destination = "192.0.2.10"
+  port        = %[2]d
+}
+`, rName, port))
+}
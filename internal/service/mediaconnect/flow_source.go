@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_mediaconnect_flow_source", name="Flow Source")
+func ResourceFlowSource() *schema.Resource {
+	s := sourceSchema()
+	s["flow_arn"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: verify.ValidARN,
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFlowSourceCreate,
+		ReadWithoutTimeout:   resourceFlowSourceRead,
+		UpdateWithoutTimeout: resourceFlowSourceUpdate,
+		DeleteWithoutTimeout: resourceFlowSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+func resourceFlowSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	flowARN := d.Get("flow_arn").(string)
+	name := d.Get("name").(string)
+	input := &mediaconnect.AddFlowSourcesInput{
+		FlowArn: aws.String(flowARN),
+		Sources: []*mediaconnect.SetSourceRequest{expandSetSourceRequest(resourceDataMap(d, sourceSchema()))},
+	}
+
+	output, err := conn.AddFlowSourcesWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("adding MediaConnect Flow (%s) source (%s): %s", flowARN, name, err)
+	}
+
+	if output == nil || len(output.Sources) == 0 || output.Sources[0] == nil {
+		return diag.Errorf("adding MediaConnect Flow (%s) source (%s): empty result", flowARN, name)
+	}
+
+	d.SetId(FlowSourceCreateResourceID(flowARN, aws.StringValue(output.Sources[0].SourceArn)))
+
+	if _, err := waitFlowUpdated(ctx, conn, flowARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for MediaConnect Flow (%s) update: %s", flowARN, err)
+	}
+
+	return resourceFlowSourceRead(ctx, d, meta)
+}
+
+func resourceFlowSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	flowARN, sourceARN, err := FlowSourceParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	source, err := FindFlowSourceByTwoPartKey(ctx, conn, flowARN, sourceARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaConnect Flow Source %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading MediaConnect Flow Source (%s): %s", d.Id(), err)
+	}
+
+	d.Set("flow_arn", flowARN)
+	for k, v := range flattenSource(source) {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("setting %s: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceFlowSourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	flowARN, sourceARN, err := FlowSourceParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := expandUpdateFlowSourceInput(flowARN, sourceARN, resourceDataMap(d, sourceSchema()))
+
+	_, err = conn.UpdateFlowSourceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating MediaConnect Flow Source (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFlowUpdated(ctx, conn, flowARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("waiting for MediaConnect Flow (%s) update: %s", flowARN, err)
+	}
+
+	return resourceFlowSourceRead(ctx, d, meta)
+}
+
+func resourceFlowSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn(ctx)
+
+	flowARN, sourceARN, err := FlowSourceParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting MediaConnect Flow Source: %s", d.Id())
+	_, err = conn.RemoveFlowSourceWithContext(ctx, &mediaconnect.RemoveFlowSourceInput{
+		FlowArn:   aws.String(flowARN),
+		SourceArn: aws.String(sourceARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting MediaConnect Flow Source (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFlowUpdated(ctx, conn, flowARN, d.Timeout(schema.TimeoutDelete)); err != nil && !tfresource.NotFound(err) {
+		return diag.Errorf("waiting for MediaConnect Flow (%s) update: %s", flowARN, err)
+	}
+
+	return nil
+}
+
+const flowSourceResourceIDSeparator = ","
+
+func FlowSourceCreateResourceID(flowARN, sourceARN string) string {
+	parts := []string{flowARN, sourceARN}
+	id := strings.Join(parts, flowSourceResourceIDSeparator)
+
+	return id
+}
+
+func FlowSourceParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, flowSourceResourceIDSeparator)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected flow-arn%[2]ssource-arn", id, flowSourceResourceIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func FindFlowSourceByTwoPartKey(ctx context.Context, conn *mediaconnect.MediaConnect, flowARN, sourceARN string) (*mediaconnect.Source, error) {
+	flow, err := FindFlowByARN(ctx, conn, flowARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range flow.Sources {
+		if v != nil && aws.StringValue(v.SourceArn) == sourceARN {
+			return v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{}
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconnect "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMediaConnectFlowSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediaconnect.Source
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow_source.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowSourceConfig_basic(rName, "10.24.36.0/23"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowSourceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "flow_arn", "aws_mediaconnect_flow.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "ingest_port", "5001"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-backup"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", mediaconnect.ProtocolRtpFec),
+					resource.TestCheckResourceAttrSet(resourceName, "source_arn"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist_cidr", "10.24.36.0/23"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowSourceConfig_basic(rName, "10.24.38.0/23"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowSourceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "whitelist_cidr", "10.24.38.0/23"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlowSource_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediaconnect.Source
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow_source.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowSourceConfig_basic(rName, "10.24.36.0/23"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowSourceExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediaconnect.ResourceFlowSource(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFlowSourceExists(ctx context.Context, n string, v *mediaconnect.Source) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaConnect Flow Source ID is set")
+		}
+
+		flowARN, sourceARN, err := tfmediaconnect.FlowSourceParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectConn(ctx)
+
+		output, err := tfmediaconnect.FindFlowSourceByTwoPartKey(ctx, conn, flowARN, sourceARN)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckFlowSourceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediaconnect_flow_source" {
+				continue
+			}
+
+			flowARN, sourceARN, err := tfmediaconnect.FlowSourceParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfmediaconnect.FindFlowSourceByTwoPartKey(ctx, conn, flowARN, sourceARN)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaConnect Flow Source %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccFlowSourceConfig_basic(rName, whitelistCIDR string) string {
+	return acctest.ConfigCompose(testAccFlowConfig_sourceFailoverConfig(rName, mediaconnect.FailoverModeFailover, 200), fmt.Sprintf(`
+resource "aws_mediaconnect_flow_source" "test" {
+  flow_arn       = aws_mediaconnect_flow.test.arn
+  name           = "%[1]s-backup"
+  protocol       = "rtp-fec"
+  ingest_port    = 5001
+  whitelist_cidr = %[2]q
+}
+`, rName, whitelistCIDR))
+}
@@ -0,0 +1,533 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconnect "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMediaConnectFlow_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediaconnect.Flow
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mediaconnect", regexp.MustCompile(`flow:.+:`+rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "availability_zone"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.name", rName),
+					resource.TestCheckResourceAttr(resourceName, "source.0.protocol", mediaconnect.ProtocolRtp),
+					resource.TestCheckResourceAttr(resourceName, "source.0.whitelist_cidr", "10.24.34.0/23"),
+					resource.TestCheckResourceAttrSet(resourceName, "source.0.source_arn"),
+					resource.TestCheckResourceAttr(resourceName, "start_flow", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", mediaconnect.StatusStandby),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_interface.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediaconnect.Flow
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediaconnect.ResourceFlow(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_startFlow(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediaconnect.Flow
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_startFlow(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "start_flow", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", mediaconnect.StatusActive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowConfig_startFlow(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "start_flow", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", mediaconnect.StatusStandby),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_outputsAndEntitlements(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediaconnect.Flow
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_outputsAndEntitlements(rName, 5000, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.description", "first"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "entitlement.0.entitlement_arn"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.subscribers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.destination", "192.0.2.10"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "output.0.output_arn"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.port", "5000"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.protocol", mediaconnect.ProtocolRtp),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowConfig_outputsAndEntitlements(rName, 5010, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.description", "second"),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.port", "5010"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_sourceFailoverConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediaconnect.Flow
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_sourceFailoverConfig(rName, mediaconnect.FailoverModeMerge, 200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "source_failover_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_failover_config.0.failover_mode", mediaconnect.FailoverModeMerge),
+					resource.TestCheckResourceAttr(resourceName, "source_failover_config.0.recovery_window", "200"),
+					resource.TestCheckResourceAttr(resourceName, "source_failover_config.0.state", mediaconnect.StateEnabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowConfig_sourceFailoverConfig(rName, mediaconnect.FailoverModeMerge, 400),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "source_failover_config.0.recovery_window", "400"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_vpcInterface(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediaconnect.Flow
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_vpcInterface(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "vpc_interface.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_interface.0.name", rName),
+					resource.TestCheckResourceAttr(resourceName, "vpc_interface.0.network_interface_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_interface.0.network_interface_type", mediaconnect.NetworkInterfaceTypeEna),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_interface.0.role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_interface.0.security_group_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_interface.0.subnet_id", "aws_subnet.test.0", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediaconnect.Flow
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFlowConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFlowExists(ctx context.Context, n string, v *mediaconnect.Flow) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaConnect Flow ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectConn(ctx)
+
+		output, err := tfmediaconnect.FindFlowByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckFlowDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediaconnect_flow" {
+				continue
+			}
+
+			_, err := tfmediaconnect.FindFlowByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaConnect Flow %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccFlowConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = %[1]q
+    protocol       = "rtp"
+    ingest_port    = 5000
+    whitelist_cidr = "10.24.34.0/23"
+  }
+}
+`, rName)
+}
+
+func testAccFlowConfig_startFlow(rName string, startFlow bool) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name       = %[1]q
+  start_flow = %[2]t
+
+  source {
+    name           = %[1]q
+    protocol       = "rtp"
+    ingest_port    = 5000
+    whitelist_cidr = "10.24.34.0/23"
+  }
+}
+`, rName, startFlow)
+}
+
+func testAccFlowConfig_outputsAndEntitlements(rName string, port int, description string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = %[1]q
+    protocol       = "rtp"
+    ingest_port    = 5000
+    whitelist_cidr = "10.24.34.0/23"
+  }
+
+  output {
+    name        = %[1]q
+    protocol    = "rtp"
+    destination = "192.0.2.10"
+    port        = %[2]d
+  }
+
+  entitlement {
+    name        = %[1]q
+    description = %[3]q
+    subscribers = [data.aws_caller_identity.current.account_id]
+  }
+}
+`, rName, port, description)
+}
+
+func testAccFlowConfig_sourceFailoverConfig(rName, failoverMode string, recoveryWindow int) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = %[1]q
+    protocol       = "rtp-fec"
+    ingest_port    = 5000
+    whitelist_cidr = "10.24.34.0/23"
+  }
+
+  source_failover_config {
+    failover_mode   = %[2]q
+    recovery_window = %[3]d
+    state           = "ENABLED"
+  }
+}
+`, rName, failoverMode, recoveryWindow)
+}
+
+func testAccFlowConfig_vpcInterface(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "mediaconnect.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "ec2:CreateNetworkInterface",
+        "ec2:CreateNetworkInterfacePermission",
+        "ec2:DeleteNetworkInterface",
+        "ec2:DeleteNetworkInterfacePermission",
+        "ec2:DescribeNetworkInterfaces",
+        "ec2:DescribeSecurityGroups",
+        "ec2:DescribeSubnets",
+      ]
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_mediaconnect_flow" "test" {
+  name              = %[1]q
+  availability_zone = aws_subnet.test[0].availability_zone
+
+  source {
+    name               = %[1]q
+    protocol           = "rtp"
+    ingest_port        = 5000
+    vpc_interface_name = %[1]q
+  }
+
+  vpc_interface {
+    name               = %[1]q
+    role_arn           = aws_iam_role.test.arn
+    security_group_ids = [aws_security_group.test.id]
+    subnet_id          = aws_subnet.test[0].id
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccFlowConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = %[1]q
+    protocol       = "rtp"
+    ingest_port    = 5000
+    whitelist_cidr = "10.24.34.0/23"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccFlowConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = %[1]q
+    protocol       = "rtp"
+    ingest_port    = 5000
+    whitelist_cidr = "10.24.34.0/23"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,521 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func encryptionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"algorithm": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(mediaconnect.Algorithm_Values(), false),
+				},
+				"constant_initialization_vector": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"device_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"key_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(mediaconnect.KeyType_Values(), false),
+				},
+				"region": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"resource_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"secret_arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"url": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+// sourceSchema returns the arguments of a flow source, shared by the flow's
+// primary source block and the flow source resource.
+func sourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"decryption": encryptionSchema(),
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"entitlement_arn": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidARN,
+		},
+		"ingest_ip": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ingest_port": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"max_bitrate": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"max_latency": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"min_latency": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"protocol": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(mediaconnect.Protocol_Values(), false),
+		},
+		"source_arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"source_listener_address": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"source_listener_port": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"stream_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"vpc_interface_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"whitelist_cidr": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidCIDRNetworkAddress,
+		},
+	}
+}
+
+// outputSchema returns the arguments of a flow output, shared by the flow's
+// output blocks and the flow output resource.
+func outputSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cidr_allow_list": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: verify.ValidCIDRNetworkAddress,
+			},
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"destination": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"encryption": encryptionSchema(),
+		"max_latency": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"min_latency": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"output_arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"port": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"protocol": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(mediaconnect.Protocol_Values(), false),
+		},
+		"remote_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"smoothing_latency": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"stream_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"vpc_interface_attachment": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"vpc_interface_name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandEncryption(tfList []interface{}) *mediaconnect.Encryption {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &mediaconnect.Encryption{
+		RoleArn: aws.String(tfMap["role_arn"].(string)),
+	}
+
+	if v, ok := tfMap["algorithm"].(string); ok && v != "" {
+		apiObject.Algorithm = aws.String(v)
+	}
+
+	if v, ok := tfMap["constant_initialization_vector"].(string); ok && v != "" {
+		apiObject.ConstantInitializationVector = aws.String(v)
+	}
+
+	if v, ok := tfMap["device_id"].(string); ok && v != "" {
+		apiObject.DeviceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_type"].(string); ok && v != "" {
+		apiObject.KeyType = aws.String(v)
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		apiObject.Region = aws.String(v)
+	}
+
+	if v, ok := tfMap["resource_id"].(string); ok && v != "" {
+		apiObject.ResourceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["secret_arn"].(string); ok && v != "" {
+		apiObject.SecretArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["url"].(string); ok && v != "" {
+		apiObject.Url = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandUpdateEncryption(tfList []interface{}) *mediaconnect.UpdateEncryption {
+	apiObject := expandEncryption(tfList)
+
+	if apiObject == nil {
+		return nil
+	}
+
+	return &mediaconnect.UpdateEncryption{
+		Algorithm:                    apiObject.Algorithm,
+		ConstantInitializationVector: apiObject.ConstantInitializationVector,
+		DeviceId:                     apiObject.DeviceId,
+		KeyType:                      apiObject.KeyType,
+		Region:                       apiObject.Region,
+		ResourceId:                   apiObject.ResourceId,
+		RoleArn:                      apiObject.RoleArn,
+		SecretArn:                    apiObject.SecretArn,
+		Url:                          apiObject.Url,
+	}
+}
+
+func flattenEncryption(apiObject *mediaconnect.Encryption) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"algorithm":                      aws.StringValue(apiObject.Algorithm),
+		"constant_initialization_vector": aws.StringValue(apiObject.ConstantInitializationVector),
+		"device_id":                      aws.StringValue(apiObject.DeviceId),
+		"key_type":                       aws.StringValue(apiObject.KeyType),
+		"region":                         aws.StringValue(apiObject.Region),
+		"resource_id":                    aws.StringValue(apiObject.ResourceId),
+		"role_arn":                       aws.StringValue(apiObject.RoleArn),
+		"secret_arn":                     aws.StringValue(apiObject.SecretArn),
+		"url":                            aws.StringValue(apiObject.Url),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandSetSourceRequest(tfMap map[string]interface{}) *mediaconnect.SetSourceRequest {
+	apiObject := &mediaconnect.SetSourceRequest{
+		Decryption: expandEncryption(tfMap["decryption"].([]interface{})),
+		Name:       aws.String(tfMap["name"].(string)),
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["entitlement_arn"].(string); ok && v != "" {
+		apiObject.EntitlementArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["ingest_port"].(int); ok && v != 0 {
+		apiObject.IngestPort = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_bitrate"].(int); ok && v != 0 {
+		apiObject.MaxBitrate = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_latency"].(int); ok && v != 0 {
+		apiObject.MaxLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["min_latency"].(int); ok && v != 0 {
+		apiObject.MinLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = aws.String(v)
+	}
+
+	if v, ok := tfMap["source_listener_address"].(string); ok && v != "" {
+		apiObject.SourceListenerAddress = aws.String(v)
+	}
+
+	if v, ok := tfMap["source_listener_port"].(int); ok && v != 0 {
+		apiObject.SourceListenerPort = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["stream_id"].(string); ok && v != "" {
+		apiObject.StreamId = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc_interface_name"].(string); ok && v != "" {
+		apiObject.VpcInterfaceName = aws.String(v)
+	}
+
+	if v, ok := tfMap["whitelist_cidr"].(string); ok && v != "" {
+		apiObject.WhitelistCidr = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandUpdateFlowSourceInput(flowARN, sourceARN string, tfMap map[string]interface{}) *mediaconnect.UpdateFlowSourceInput {
+	apiObject := expandSetSourceRequest(tfMap)
+
+	return &mediaconnect.UpdateFlowSourceInput{
+		Decryption:            expandUpdateEncryption(tfMap["decryption"].([]interface{})),
+		Description:           apiObject.Description,
+		EntitlementArn:        apiObject.EntitlementArn,
+		FlowArn:               aws.String(flowARN),
+		IngestPort:            apiObject.IngestPort,
+		MaxBitrate:            apiObject.MaxBitrate,
+		MaxLatency:            apiObject.MaxLatency,
+		MinLatency:            apiObject.MinLatency,
+		Protocol:              apiObject.Protocol,
+		SourceArn:             aws.String(sourceARN),
+		SourceListenerAddress: apiObject.SourceListenerAddress,
+		SourceListenerPort:    apiObject.SourceListenerPort,
+		StreamId:              apiObject.StreamId,
+		VpcInterfaceName:      apiObject.VpcInterfaceName,
+		WhitelistCidr:         apiObject.WhitelistCidr,
+	}
+}
+
+func flattenSource(apiObject *mediaconnect.Source) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"decryption":         flattenEncryption(apiObject.Decryption),
+		"description":        aws.StringValue(apiObject.Description),
+		"entitlement_arn":    aws.StringValue(apiObject.EntitlementArn),
+		"ingest_ip":          aws.StringValue(apiObject.IngestIp),
+		"ingest_port":        aws.Int64Value(apiObject.IngestPort),
+		"name":               aws.StringValue(apiObject.Name),
+		"source_arn":         aws.StringValue(apiObject.SourceArn),
+		"vpc_interface_name": aws.StringValue(apiObject.VpcInterfaceName),
+		"whitelist_cidr":     aws.StringValue(apiObject.WhitelistCidr),
+	}
+
+	if v := apiObject.Transport; v != nil {
+		tfMap["max_bitrate"] = aws.Int64Value(v.MaxBitrate)
+		tfMap["max_latency"] = aws.Int64Value(v.MaxLatency)
+		tfMap["min_latency"] = aws.Int64Value(v.MinLatency)
+		tfMap["protocol"] = aws.StringValue(v.Protocol)
+		tfMap["source_listener_address"] = aws.StringValue(v.SourceListenerAddress)
+		tfMap["source_listener_port"] = aws.Int64Value(v.SourceListenerPort)
+		tfMap["stream_id"] = aws.StringValue(v.StreamId)
+	}
+
+	return tfMap
+}
+
+func expandAddOutputRequest(tfMap map[string]interface{}) *mediaconnect.AddOutputRequest {
+	apiObject := &mediaconnect.AddOutputRequest{
+		Encryption: expandEncryption(tfMap["encryption"].([]interface{})),
+		Name:       aws.String(tfMap["name"].(string)),
+		Protocol:   aws.String(tfMap["protocol"].(string)),
+	}
+
+	if v, ok := tfMap["cidr_allow_list"].([]interface{}); ok && len(v) > 0 {
+		apiObject.CidrAllowList = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["destination"].(string); ok && v != "" {
+		apiObject.Destination = aws.String(v)
+	}
+
+	if v, ok := tfMap["max_latency"].(int); ok && v != 0 {
+		apiObject.MaxLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["min_latency"].(int); ok && v != 0 {
+		apiObject.MinLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["port"].(int); ok && v != 0 {
+		apiObject.Port = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["remote_id"].(string); ok && v != "" {
+		apiObject.RemoteId = aws.String(v)
+	}
+
+	if v, ok := tfMap["smoothing_latency"].(int); ok && v != 0 {
+		apiObject.SmoothingLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["stream_id"].(string); ok && v != "" {
+		apiObject.StreamId = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc_interface_attachment"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.VpcInterfaceAttachment = &mediaconnect.VpcInterfaceAttachment{
+			VpcInterfaceName: aws.String(v[0].(map[string]interface{})["vpc_interface_name"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandUpdateFlowOutputInput(flowARN, outputARN string, tfMap map[string]interface{}) *mediaconnect.UpdateFlowOutputInput {
+	apiObject := expandAddOutputRequest(tfMap)
+
+	return &mediaconnect.UpdateFlowOutputInput{
+		CidrAllowList:          apiObject.CidrAllowList,
+		Description:            apiObject.Description,
+		Destination:            apiObject.Destination,
+		Encryption:             expandUpdateEncryption(tfMap["encryption"].([]interface{})),
+		FlowArn:                aws.String(flowARN),
+		MaxLatency:             apiObject.MaxLatency,
+		MinLatency:             apiObject.MinLatency,
+		OutputArn:              aws.String(outputARN),
+		Port:                   apiObject.Port,
+		Protocol:               apiObject.Protocol,
+		RemoteId:               apiObject.RemoteId,
+		SmoothingLatency:       apiObject.SmoothingLatency,
+		StreamId:               apiObject.StreamId,
+		VpcInterfaceAttachment: apiObject.VpcInterfaceAttachment,
+	}
+}
+
+func flattenOutput(apiObject *mediaconnect.Output) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"description": aws.StringValue(apiObject.Description),
+		"destination": aws.StringValue(apiObject.Destination),
+		"encryption":  flattenEncryption(apiObject.Encryption),
+		"name":        aws.StringValue(apiObject.Name),
+		"output_arn":  aws.StringValue(apiObject.OutputArn),
+		"port":        aws.Int64Value(apiObject.Port),
+	}
+
+	if v := apiObject.Transport; v != nil {
+		tfMap["cidr_allow_list"] = aws.StringValueSlice(v.CidrAllowList)
+		tfMap["max_latency"] = aws.Int64Value(v.MaxLatency)
+		tfMap["min_latency"] = aws.Int64Value(v.MinLatency)
+		tfMap["protocol"] = aws.StringValue(v.Protocol)
+		tfMap["remote_id"] = aws.StringValue(v.RemoteId)
+		tfMap["smoothing_latency"] = aws.Int64Value(v.SmoothingLatency)
+		tfMap["stream_id"] = aws.StringValue(v.StreamId)
+	}
+
+	if v := apiObject.VpcInterfaceAttachment; v != nil {
+		tfMap["vpc_interface_attachment"] = []interface{}{map[string]interface{}{
+			"vpc_interface_name": aws.StringValue(v.VpcInterfaceName),
+		}}
+	}
+
+	return tfMap
+}
@@ -28,7 +28,26 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceFlow,
+			TypeName: "aws_mediaconnect_flow",
+			Name:     "Flow",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceFlowOutput,
+			TypeName: "aws_mediaconnect_flow_output",
+			Name:     "Flow Output",
+		},
+		{
+			Factory:  ResourceFlowSource,
+			TypeName: "aws_mediaconnect_flow_source",
+			Name:     "Flow Source",
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build sweep
+// +build sweep
+
+package mediaconnect
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_mediaconnect_flow", &resource.Sweeper{
+		Name: "aws_mediaconnect_flow",
+		F:    sweepFlows,
+	})
+}
+
+func sweepFlows(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.MediaConnectConn(ctx)
+	input := &mediaconnect.ListFlowsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListFlowsPagesWithContext(ctx, input, func(page *mediaconnect.ListFlowsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Flows {
+			r := ResourceFlow()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.FlowArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping MediaConnect Flow sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing MediaConnect Flows (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping MediaConnect Flows (%s): %w", region, err)
+	}
+
+	return nil
+}
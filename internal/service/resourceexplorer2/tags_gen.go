@@ -7,11 +7,13 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
-	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -42,7 +44,7 @@ func (p *servicePackage) ListTags(ctx context.Context, meta any, identifier stri
 	}
 
 	if inContext, ok := tftags.FromContext(ctx); ok {
-		inContext.TagsOut = types.Some(tags)
+		inContext.TagsOut = itypes.Some(tags)
 	}
 
 	return nil
@@ -75,7 +77,7 @@ func getTagsIn(ctx context.Context) map[string]string {
 // setTagsOut sets resourceexplorer2 service tags in Context.
 func setTagsOut(ctx context.Context, tags map[string]string) {
 	if inContext, ok := tftags.FromContext(ctx); ok {
-		inContext.TagsOut = types.Some(KeyValueTags(ctx, tags))
+		inContext.TagsOut = itypes.Some(KeyValueTags(ctx, tags))
 	}
 }
 
@@ -126,3 +128,24 @@ func updateTags(ctx context.Context, conn *resourceexplorer2.Client, identifier
 func (p *servicePackage) UpdateTags(ctx context.Context, meta any, identifier string, oldTags, newTags any) error {
 	return updateTags(ctx, meta.(*conns.AWSClient).ResourceExplorer2Client(ctx), identifier, oldTags, newTags)
 }
+
+// Terraform Plugin Framework tags handling
+
+// TagsFromFramework returns resourceexplorer2 service tags from a Terraform Plugin Framework tags map.
+// AWS reserved tags are ignored.
+func TagsFromFramework(ctx context.Context, tags types.Map) map[string]string {
+	return Tags(tftags.New(ctx, tags).IgnoreAWS())
+}
+
+// TagsToFramework returns a Terraform Plugin Framework tags map from resourceexplorer2 service tags.
+// AWS reserved tags are ignored. A null map is returned if there are no tags.
+func TagsToFramework(ctx context.Context, tags map[string]string) types.Map {
+	return flex.FlattenFrameworkStringValueMap(ctx, KeyValueTags(ctx, tags).IgnoreAWS().Map())
+}
+
+// updateTagsFramework updates resourceexplorer2 service tags from Terraform Plugin Framework tags maps.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func updateTagsFramework(ctx context.Context, conn *resourceexplorer2.Client, identifier string, oldTags, newTags types.Map) error {
+	return updateTags(ctx, conn, identifier, tftags.New(ctx, oldTags), tftags.New(ctx, newTags))
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceexplorer2_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfresourceexplorer2 "github.com/hashicorp/terraform-provider-aws/internal/service/resourceexplorer2"
+)
+
+func TestTagsFramework(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		input    types.Map
+		expected map[string]string
+	}{
+		"null": {
+			input:    types.MapNull(types.StringType),
+			expected: map[string]string{},
+		},
+		"tags": {
+			input: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"key1": types.StringValue("value1"),
+				"key2": types.StringValue("value2"),
+			}),
+			expected: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		"AWS reserved tags": {
+			input: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"aws:cloudformation:stack-name": types.StringValue("stack"),
+				"key1":                          types.StringValue("value1"),
+			}),
+			expected: map[string]string{
+				"key1": "value1",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfresourceexplorer2.TagsFromFramework(ctx, testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected tags (-got +want): %s", diff)
+			}
+
+			roundTrip := tfresourceexplorer2.TagsFromFramework(ctx, tfresourceexplorer2.TagsToFramework(ctx, got))
+
+			if diff := cmp.Diff(roundTrip, testCase.expected); diff != "" {
+				t.Errorf("unexpected round-trip tags (-got +want): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,703 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_securityhub_automation_rule", name="Automation Rule")
+// @Tags(identifierAttribute="arn")
+func ResourceAutomationRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAutomationRuleCreate,
+		ReadWithoutTimeout:   resourceAutomationRuleRead,
+		UpdateWithoutTimeout: resourceAutomationRuleUpdate,
+		DeleteWithoutTimeout: resourceAutomationRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"actions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"finding_fields_update": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"confidence": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+									"criticality": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+									"note": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"text": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"updated_by": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"related_findings": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"product_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+									"severity": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"label": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(securityhub.SeverityLabel_Values(), false),
+												},
+												"product": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidTypeStringNullableFloat,
+												},
+											},
+										},
+									},
+									"types": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"user_defined_fields": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"verification_state": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(securityhub.VerificationState_Values(), false),
+									},
+									"workflow": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"status": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(securityhub.WorkflowStatus_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      securityhub.AutomationRulesActionTypeFindingFieldsUpdate,
+							ValidateFunc: validation.StringInSlice(securityhub.AutomationRulesActionType_Values(), false),
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"criteria": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_account_id":                     stringFilterSchema(),
+						"company_name":                       stringFilterSchema(),
+						"compliance_associated_standards_id": stringFilterSchema(),
+						"compliance_security_control_id":     stringFilterSchema(),
+						"compliance_status":                  stringFilterSchema(),
+						"confidence":                         numberFilterSchema(),
+						"created_at":                         dateFilterSchema(),
+						"criticality":                        numberFilterSchema(),
+						"description":                        stringFilterSchema(),
+						"first_observed_at":                  dateFilterSchema(),
+						"generator_id":                       stringFilterSchema(),
+						"id":                                 stringFilterSchema(),
+						"last_observed_at":                   dateFilterSchema(),
+						"note_text":                          stringFilterSchema(),
+						"note_updated_at":                    dateFilterSchema(),
+						"note_updated_by":                    stringFilterSchema(),
+						"product_arn":                        stringFilterSchema(),
+						"product_name":                       stringFilterSchema(),
+						"record_state":                       stringFilterSchema(),
+						"related_findings_id":                stringFilterSchema(),
+						"related_findings_product_arn":       stringFilterSchema(),
+						"resource_details_other":             mapFilterSchema(),
+						"resource_id":                        stringFilterSchema(),
+						"resource_partition":                 stringFilterSchema(),
+						"resource_region":                    stringFilterSchema(),
+						"resource_tags":                      mapFilterSchema(),
+						"resource_type":                      stringFilterSchema(),
+						"severity_label":                     stringFilterSchema(),
+						"source_url":                         stringFilterSchema(),
+						"title":                              stringFilterSchema(),
+						"type":                               stringFilterSchema(),
+						"updated_at":                         dateFilterSchema(),
+						"user_defined_fields":                mapFilterSchema(),
+						"verification_state":                 stringFilterSchema(),
+						"workflow_status":                    workflowStatusSchema(),
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"is_terminal": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"rule_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"rule_order": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+			"rule_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      securityhub.RuleStatusEnabled,
+				ValidateFunc: validation.StringInSlice(securityhub.RuleStatus_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAutomationRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	name := d.Get("rule_name").(string)
+	input := &securityhub.CreateAutomationRuleInput{
+		Actions:     expandAutomationRulesActions(d.Get("actions").(*schema.Set).List()),
+		Criteria:    expandAutomationRulesFindingFilters(d.Get("criteria").([]interface{})),
+		Description: aws.String(d.Get("description").(string)),
+		IsTerminal:  aws.Bool(d.Get("is_terminal").(bool)),
+		RuleName:    aws.String(name),
+		RuleOrder:   aws.Int64(int64(d.Get("rule_order").(int))),
+		RuleStatus:  aws.String(d.Get("rule_status").(string)),
+		Tags:        getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateAutomationRuleWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Security Hub Automation Rule (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.RuleArn))
+
+	return resourceAutomationRuleRead(ctx, d, meta)
+}
+
+func resourceAutomationRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	rule, err := FindAutomationRuleByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Hub Automation Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Security Hub Automation Rule (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("actions", flattenAutomationRulesActions(rule.Actions)); err != nil {
+		return diag.Errorf("setting actions: %s", err)
+	}
+	d.Set("arn", rule.RuleArn)
+	if err := d.Set("criteria", flattenAutomationRulesFindingFilters(rule.Criteria)); err != nil {
+		return diag.Errorf("setting criteria: %s", err)
+	}
+	d.Set("description", rule.Description)
+	d.Set("is_terminal", rule.IsTerminal)
+	d.Set("rule_name", rule.RuleName)
+	d.Set("rule_order", rule.RuleOrder)
+	d.Set("rule_status", rule.RuleStatus)
+
+	return nil
+}
+
+func resourceAutomationRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		item := &securityhub.UpdateAutomationRulesRequestItem{
+			RuleArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("actions") {
+			item.Actions = expandAutomationRulesActions(d.Get("actions").(*schema.Set).List())
+		}
+
+		if d.HasChange("criteria") {
+			item.Criteria = expandAutomationRulesFindingFilters(d.Get("criteria").([]interface{}))
+		}
+
+		if d.HasChange("description") {
+			item.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("is_terminal") {
+			item.IsTerminal = aws.Bool(d.Get("is_terminal").(bool))
+		}
+
+		if d.HasChange("rule_name") {
+			item.RuleName = aws.String(d.Get("rule_name").(string))
+		}
+
+		if d.HasChange("rule_order") {
+			item.RuleOrder = aws.Int64(int64(d.Get("rule_order").(int)))
+		}
+
+		if d.HasChange("rule_status") {
+			item.RuleStatus = aws.String(d.Get("rule_status").(string))
+		}
+
+		input := &securityhub.BatchUpdateAutomationRulesInput{
+			UpdateAutomationRulesRequestItems: []*securityhub.UpdateAutomationRulesRequestItem{item},
+		}
+
+		output, err := conn.BatchUpdateAutomationRulesWithContext(ctx, input)
+
+		if err == nil {
+			err = unprocessedAutomationRulesError(output.UnprocessedAutomationRules)
+		}
+
+		if err != nil {
+			return diag.Errorf("updating Security Hub Automation Rule (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAutomationRuleRead(ctx, d, meta)
+}
+
+func resourceAutomationRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Security Hub Automation Rule: %s", d.Id())
+	output, err := conn.BatchDeleteAutomationRulesWithContext(ctx, &securityhub.BatchDeleteAutomationRulesInput{
+		AutomationRulesArns: aws.StringSlice([]string{d.Id()}),
+	})
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err == nil {
+		// A rule that no longer exists is reported as unprocessed rather than as an error.
+		err = unprocessedAutomationRulesError(slices.Filter(output.UnprocessedAutomationRules, func(v *securityhub.UnprocessedAutomationRule) bool {
+			return !unprocessedAutomationRuleNotFound(v)
+		}))
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Security Hub Automation Rule (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func unprocessedAutomationRulesError(apiObjects []*securityhub.UnprocessedAutomationRule) error {
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		return fmt.Errorf("%s: %s", strconv.FormatInt(aws.Int64Value(apiObject.ErrorCode), 10), aws.StringValue(apiObject.ErrorMessage))
+	}
+
+	return nil
+}
+
+func unprocessedAutomationRuleNotFound(apiObject *securityhub.UnprocessedAutomationRule) bool {
+	if apiObject == nil {
+		return false
+	}
+
+	return aws.Int64Value(apiObject.ErrorCode) == http.StatusNotFound || strings.Contains(aws.StringValue(apiObject.ErrorMessage), securityhub.ErrCodeResourceNotFoundException)
+}
+
+func expandAutomationRulesActions(tfList []interface{}) []*securityhub.AutomationRulesAction {
+	var apiObjects []*securityhub.AutomationRulesAction
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &securityhub.AutomationRulesAction{}
+
+		if v, ok := tfMap["finding_fields_update"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.FindingFieldsUpdate = expandAutomationRulesFindingFieldsUpdate(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAutomationRulesFindingFieldsUpdate(tfMap map[string]interface{}) *securityhub.AutomationRulesFindingFieldsUpdate {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &securityhub.AutomationRulesFindingFieldsUpdate{}
+
+	if v, ok := tfMap["confidence"].(int); ok && v != 0 {
+		apiObject.Confidence = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["criticality"].(int); ok && v != 0 {
+		apiObject.Criticality = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["note"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Note = &securityhub.NoteUpdate{
+			Text:      aws.String(tfMap["text"].(string)),
+			UpdatedBy: aws.String(tfMap["updated_by"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["related_findings"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			apiObject.RelatedFindings = append(apiObject.RelatedFindings, &securityhub.RelatedFinding{
+				Id:         aws.String(tfMap["id"].(string)),
+				ProductArn: aws.String(tfMap["product_arn"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["severity"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		severity := &securityhub.SeverityUpdate{}
+
+		if v, ok := tfMap["label"].(string); ok && v != "" {
+			severity.Label = aws.String(v)
+		}
+
+		if v, ok := tfMap["product"].(string); ok && v != "" {
+			if v, err := strconv.ParseFloat(v, 64); err == nil {
+				severity.Product = aws.Float64(v)
+			}
+		}
+
+		apiObject.Severity = severity
+	}
+
+	if v, ok := tfMap["types"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Types = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["user_defined_fields"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.UserDefinedFields = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["verification_state"].(string); ok && v != "" {
+		apiObject.VerificationState = aws.String(v)
+	}
+
+	if v, ok := tfMap["workflow"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Workflow = &securityhub.WorkflowUpdate{
+			Status: aws.String(tfMap["status"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+// expandAutomationRulesFindingFilters always returns a non-nil value as the
+// criteria are required by the API, even if no filters are configured.
+func expandAutomationRulesFindingFilters(l []interface{}) *securityhub.AutomationRulesFindingFilters {
+	filters := &securityhub.AutomationRulesFindingFilters{}
+
+	if len(l) == 0 || l[0] == nil {
+		return filters
+	}
+
+	tfMap, ok := l[0].(map[string]interface{})
+	if !ok {
+		return filters
+	}
+
+	stringFilters := func(k string) []*securityhub.StringFilter {
+		if v, ok := tfMap[k].(*schema.Set); ok && v.Len() > 0 {
+			return expandStringFilters(v.List())
+		}
+		return nil
+	}
+	numberFilters := func(k string) []*securityhub.NumberFilter {
+		if v, ok := tfMap[k].(*schema.Set); ok && v.Len() > 0 {
+			return expandNumberFilters(v.List())
+		}
+		return nil
+	}
+	dateFilters := func(k string) []*securityhub.DateFilter {
+		if v, ok := tfMap[k].(*schema.Set); ok && v.Len() > 0 {
+			return expandDateFilters(v.List())
+		}
+		return nil
+	}
+	mapFilters := func(k string) []*securityhub.MapFilter {
+		if v, ok := tfMap[k].(*schema.Set); ok && v.Len() > 0 {
+			return expandMapFilters(v.List())
+		}
+		return nil
+	}
+
+	filters.AwsAccountId = stringFilters("aws_account_id")
+	filters.CompanyName = stringFilters("company_name")
+	filters.ComplianceAssociatedStandardsId = stringFilters("compliance_associated_standards_id")
+	filters.ComplianceSecurityControlId = stringFilters("compliance_security_control_id")
+	filters.ComplianceStatus = stringFilters("compliance_status")
+	filters.Confidence = numberFilters("confidence")
+	filters.CreatedAt = dateFilters("created_at")
+	filters.Criticality = numberFilters("criticality")
+	filters.Description = stringFilters("description")
+	filters.FirstObservedAt = dateFilters("first_observed_at")
+	filters.GeneratorId = stringFilters("generator_id")
+	filters.Id = stringFilters("id")
+	filters.LastObservedAt = dateFilters("last_observed_at")
+	filters.NoteText = stringFilters("note_text")
+	filters.NoteUpdatedAt = dateFilters("note_updated_at")
+	filters.NoteUpdatedBy = stringFilters("note_updated_by")
+	filters.ProductArn = stringFilters("product_arn")
+	filters.ProductName = stringFilters("product_name")
+	filters.RecordState = stringFilters("record_state")
+	filters.RelatedFindingsId = stringFilters("related_findings_id")
+	filters.RelatedFindingsProductArn = stringFilters("related_findings_product_arn")
+	filters.ResourceDetailsOther = mapFilters("resource_details_other")
+	filters.ResourceId = stringFilters("resource_id")
+	filters.ResourcePartition = stringFilters("resource_partition")
+	filters.ResourceRegion = stringFilters("resource_region")
+	filters.ResourceTags = mapFilters("resource_tags")
+	filters.ResourceType = stringFilters("resource_type")
+	filters.SeverityLabel = stringFilters("severity_label")
+	filters.SourceUrl = stringFilters("source_url")
+	filters.Title = stringFilters("title")
+	filters.Type = stringFilters("type")
+	filters.UpdatedAt = dateFilters("updated_at")
+	filters.UserDefinedFields = mapFilters("user_defined_fields")
+	filters.VerificationState = stringFilters("verification_state")
+	filters.WorkflowStatus = stringFilters("workflow_status")
+
+	return filters
+}
+
+func flattenAutomationRulesActions(apiObjects []*securityhub.AutomationRulesAction) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"type": aws.StringValue(apiObject.Type),
+		}
+
+		if v := apiObject.FindingFieldsUpdate; v != nil {
+			tfMap["finding_fields_update"] = []interface{}{flattenAutomationRulesFindingFieldsUpdate(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenAutomationRulesFindingFieldsUpdate(apiObject *securityhub.AutomationRulesFindingFieldsUpdate) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"confidence":          aws.Int64Value(apiObject.Confidence),
+		"criticality":         aws.Int64Value(apiObject.Criticality),
+		"types":               aws.StringValueSlice(apiObject.Types),
+		"user_defined_fields": aws.StringValueMap(apiObject.UserDefinedFields),
+		"verification_state":  aws.StringValue(apiObject.VerificationState),
+	}
+
+	if v := apiObject.Note; v != nil {
+		tfMap["note"] = []interface{}{map[string]interface{}{
+			"text":       aws.StringValue(v.Text),
+			"updated_by": aws.StringValue(v.UpdatedBy),
+		}}
+	}
+
+	if v := apiObject.RelatedFindings; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			if apiObject == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"id":          aws.StringValue(apiObject.Id),
+				"product_arn": aws.StringValue(apiObject.ProductArn),
+			})
+		}
+
+		tfMap["related_findings"] = tfList
+	}
+
+	if v := apiObject.Severity; v != nil {
+		severity := map[string]interface{}{
+			"label": aws.StringValue(v.Label),
+		}
+
+		if v.Product != nil {
+			severity["product"] = strconv.FormatFloat(aws.Float64Value(v.Product), 'f', -1, 64)
+		}
+
+		tfMap["severity"] = []interface{}{severity}
+	}
+
+	if v := apiObject.Workflow; v != nil {
+		tfMap["workflow"] = []interface{}{map[string]interface{}{
+			"status": aws.StringValue(v.Status),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenAutomationRulesFindingFilters(filters *securityhub.AutomationRulesFindingFilters) []interface{} {
+	if filters == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"aws_account_id":                     flattenStringFilters(filters.AwsAccountId),
+		"company_name":                       flattenStringFilters(filters.CompanyName),
+		"compliance_associated_standards_id": flattenStringFilters(filters.ComplianceAssociatedStandardsId),
+		"compliance_security_control_id":     flattenStringFilters(filters.ComplianceSecurityControlId),
+		"compliance_status":                  flattenStringFilters(filters.ComplianceStatus),
+		"confidence":                         flattenNumberFilters(filters.Confidence),
+		"created_at":                         flattenDateFilters(filters.CreatedAt),
+		"criticality":                        flattenNumberFilters(filters.Criticality),
+		"description":                        flattenStringFilters(filters.Description),
+		"first_observed_at":                  flattenDateFilters(filters.FirstObservedAt),
+		"generator_id":                       flattenStringFilters(filters.GeneratorId),
+		"id":                                 flattenStringFilters(filters.Id),
+		"last_observed_at":                   flattenDateFilters(filters.LastObservedAt),
+		"note_text":                          flattenStringFilters(filters.NoteText),
+		"note_updated_at":                    flattenDateFilters(filters.NoteUpdatedAt),
+		"note_updated_by":                    flattenStringFilters(filters.NoteUpdatedBy),
+		"product_arn":                        flattenStringFilters(filters.ProductArn),
+		"product_name":                       flattenStringFilters(filters.ProductName),
+		"record_state":                       flattenStringFilters(filters.RecordState),
+		"related_findings_id":                flattenStringFilters(filters.RelatedFindingsId),
+		"related_findings_product_arn":       flattenStringFilters(filters.RelatedFindingsProductArn),
+		"resource_details_other":             flattenMapFilters(filters.ResourceDetailsOther),
+		"resource_id":                        flattenStringFilters(filters.ResourceId),
+		"resource_partition":                 flattenStringFilters(filters.ResourcePartition),
+		"resource_region":                    flattenStringFilters(filters.ResourceRegion),
+		"resource_tags":                      flattenMapFilters(filters.ResourceTags),
+		"resource_type":                      flattenStringFilters(filters.ResourceType),
+		"severity_label":                     flattenStringFilters(filters.SeverityLabel),
+		"source_url":                         flattenStringFilters(filters.SourceUrl),
+		"title":                              flattenStringFilters(filters.Title),
+		"type":                               flattenStringFilters(filters.Type),
+		"updated_at":                         flattenDateFilters(filters.UpdatedAt),
+		"user_defined_fields":                flattenMapFilters(filters.UserDefinedFields),
+		"verification_state":                 flattenStringFilters(filters.VerificationState),
+		"workflow_status":                    flattenStringFilters(filters.WorkflowStatus),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,710 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecurityhub "github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestExpandAutomationRulesFindingFilters(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		raw      map[string]interface{}
+		expected *securityhub.AutomationRulesFindingFilters
+	}{
+		"no criteria": {
+			raw:      map[string]interface{}{},
+			expected: &securityhub.AutomationRulesFindingFilters{},
+		},
+		"string filters": {
+			raw: map[string]interface{}{
+				"criteria": []interface{}{map[string]interface{}{
+					"aws_account_id": []interface{}{map[string]interface{}{
+						"comparison": "EQUALS",
+						"value":      "123456789012",
+					}},
+					"workflow_status": []interface{}{map[string]interface{}{
+						"comparison": "NOT_EQUALS",
+						"value":      "SUPPRESSED",
+					}},
+				}},
+			},
+			expected: &securityhub.AutomationRulesFindingFilters{
+				AwsAccountId: []*securityhub.StringFilter{{
+					Comparison: aws.String("EQUALS"),
+					Value:      aws.String("123456789012"),
+				}},
+				WorkflowStatus: []*securityhub.StringFilter{{
+					Comparison: aws.String("NOT_EQUALS"),
+					Value:      aws.String("SUPPRESSED"),
+				}},
+			},
+		},
+		"number filters": {
+			raw: map[string]interface{}{
+				"criteria": []interface{}{map[string]interface{}{
+					"criticality": []interface{}{map[string]interface{}{
+						"gte": "25.5",
+						"lte": "100",
+					}},
+				}},
+			},
+			expected: &securityhub.AutomationRulesFindingFilters{
+				Criticality: []*securityhub.NumberFilter{{
+					Gte: aws.Float64(25.5),
+					Lte: aws.Float64(100),
+				}},
+			},
+		},
+		"date filters": {
+			raw: map[string]interface{}{
+				"criteria": []interface{}{map[string]interface{}{
+					"created_at": []interface{}{map[string]interface{}{
+						"date_range": []interface{}{map[string]interface{}{
+							"unit":  "DAYS",
+							"value": 7,
+						}},
+					}},
+					"updated_at": []interface{}{map[string]interface{}{
+						"start": "2023-01-01T00:00:00Z",
+						"end":   "2023-12-31T00:00:00Z",
+					}},
+				}},
+			},
+			expected: &securityhub.AutomationRulesFindingFilters{
+				CreatedAt: []*securityhub.DateFilter{{
+					DateRange: &securityhub.DateRange{
+						Unit:  aws.String("DAYS"),
+						Value: aws.Int64(7),
+					},
+				}},
+				UpdatedAt: []*securityhub.DateFilter{{
+					End:   aws.String("2023-12-31T00:00:00Z"),
+					Start: aws.String("2023-01-01T00:00:00Z"),
+				}},
+			},
+		},
+		"map filters": {
+			raw: map[string]interface{}{
+				"criteria": []interface{}{map[string]interface{}{
+					"resource_tags": []interface{}{map[string]interface{}{
+						"comparison": "EQUALS",
+						"key":        "Environment",
+						"value":      "production",
+					}},
+				}},
+			},
+			expected: &securityhub.AutomationRulesFindingFilters{
+				ResourceTags: []*securityhub.MapFilter{{
+					Comparison: aws.String("EQUALS"),
+					Key:        aws.String("Environment"),
+					Value:      aws.String("production"),
+				}},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, tfsecurityhub.ResourceAutomationRule().Schema, testCase.raw)
+			got := tfsecurityhub.ExpandAutomationRulesFindingFilters(d.Get("criteria").([]interface{}))
+
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.IgnoreUnexported(
+				securityhub.AutomationRulesFindingFilters{},
+				securityhub.DateFilter{},
+				securityhub.DateRange{},
+				securityhub.MapFilter{},
+				securityhub.NumberFilter{},
+				securityhub.StringFilter{},
+			)); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnprocessedAutomationRuleNotFound(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		apiObject *securityhub.UnprocessedAutomationRule
+		expected  bool
+	}{
+		"nil": {
+			apiObject: nil,
+			expected:  false,
+		},
+		"not found status": {
+			apiObject: &securityhub.UnprocessedAutomationRule{
+				ErrorCode:    aws.Int64(404),
+				ErrorMessage: aws.String("Rule not found"),
+			},
+			expected: true,
+		},
+		"not found message": {
+			apiObject: &securityhub.UnprocessedAutomationRule{
+				ErrorCode:    aws.Int64(400),
+				ErrorMessage: aws.String("ResourceNotFoundException: rule does not exist"),
+			},
+			expected: true,
+		},
+		"throttled": {
+			apiObject: &securityhub.UnprocessedAutomationRule{
+				ErrorCode:    aws.Int64(429),
+				ErrorMessage: aws.String("TooManyRequestsException: rate exceeded"),
+			},
+			expected: false,
+		},
+		"access denied": {
+			apiObject: &securityhub.UnprocessedAutomationRule{
+				ErrorCode:    aws.Int64(403),
+				ErrorMessage: aws.String("AccessDeniedException"),
+			},
+			expected: false,
+		},
+		"other error": {
+			apiObject: &securityhub.UnprocessedAutomationRule{
+				ErrorCode:    aws.Int64(500),
+				ErrorMessage: aws.String("InternalException"),
+			},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfsecurityhub.UnprocessedAutomationRuleNotFound(testCase.apiObject), testCase.expected; got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestExpandAutomationRulesActions(t *testing.T) {
+	t.Parallel()
+
+	raw := map[string]interface{}{
+		"actions": []interface{}{map[string]interface{}{
+			"type": "FINDING_FIELDS_UPDATE",
+			"finding_fields_update": []interface{}{map[string]interface{}{
+				"confidence":  50,
+				"criticality": 75,
+				"note": []interface{}{map[string]interface{}{
+					"text":       "triaged",
+					"updated_by": "security-team",
+				}},
+				"related_findings": []interface{}{map[string]interface{}{
+					"id":          "finding-1",
+					"product_arn": "arn:aws:securityhub:us-west-2::product/aws/guardduty", //lintignore:AWSAT003,AWSAT005
+				}},
+				"severity": []interface{}{map[string]interface{}{
+					"label":   "LOW",
+					"product": "12.5",
+				}},
+				"types":               []interface{}{"Software and Configuration Checks/Industry and Regulatory Standards"},
+				"user_defined_fields": map[string]interface{}{"reviewed": "true"},
+				"verification_state":  "BENIGN_POSITIVE",
+				"workflow": []interface{}{map[string]interface{}{
+					"status": "SUPPRESSED",
+				}},
+			}},
+		}},
+	}
+
+	expected := []*securityhub.AutomationRulesAction{{
+		FindingFieldsUpdate: &securityhub.AutomationRulesFindingFieldsUpdate{
+			Confidence:  aws.Int64(50),
+			Criticality: aws.Int64(75),
+			Note: &securityhub.NoteUpdate{
+				Text:      aws.String("triaged"),
+				UpdatedBy: aws.String("security-team"),
+			},
+			RelatedFindings: []*securityhub.RelatedFinding{{
+				Id:         aws.String("finding-1"),
+				ProductArn: aws.String("arn:aws:securityhub:us-west-2::product/aws/guardduty"), //lintignore:AWSAT003,AWSAT005
+			}},
+			Severity: &securityhub.SeverityUpdate{
+				Label:   aws.String("LOW"),
+				Product: aws.Float64(12.5),
+			},
+			Types:             aws.StringSlice([]string{"Software and Configuration Checks/Industry and Regulatory Standards"}),
+			UserDefinedFields: aws.StringMap(map[string]string{"reviewed": "true"}),
+			VerificationState: aws.String("BENIGN_POSITIVE"),
+			Workflow: &securityhub.WorkflowUpdate{
+				Status: aws.String("SUPPRESSED"),
+			},
+		},
+		Type: aws.String("FINDING_FIELDS_UPDATE"),
+	}}
+
+	d := schema.TestResourceDataRaw(t, tfsecurityhub.ResourceAutomationRule().Schema, raw)
+	got := tfsecurityhub.ExpandAutomationRulesActions(d.Get("actions").(*schema.Set).List())
+
+	if diff := cmp.Diff(got, expected, cmpopts.IgnoreUnexported(
+		securityhub.AutomationRulesAction{},
+		securityhub.AutomationRulesFindingFieldsUpdate{},
+		securityhub.NoteUpdate{},
+		securityhub.RelatedFinding{},
+		securityhub.SeverityUpdate{},
+		securityhub.WorkflowUpdate{},
+	)); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// Flattening the expanded actions must yield a value that round-trips through state.
+	if err := d.Set("actions", tfsecurityhub.FlattenAutomationRulesActions(got)); err != nil {
+		t.Fatalf("setting actions: %s", err)
+	}
+
+	roundTripped := tfsecurityhub.ExpandAutomationRulesActions(d.Get("actions").(*schema.Set).List())
+
+	if diff := cmp.Diff(roundTripped, expected, cmpopts.IgnoreUnexported(
+		securityhub.AutomationRulesAction{},
+		securityhub.AutomationRulesFindingFieldsUpdate{},
+		securityhub.NoteUpdate{},
+		securityhub.RelatedFinding{},
+		securityhub.SeverityUpdate{},
+		securityhub.WorkflowUpdate{},
+	)); diff != "" {
+		t.Errorf("unexpected round trip diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFlattenAutomationRulesFindingFilters(t *testing.T) {
+	t.Parallel()
+
+	apiObject := &securityhub.AutomationRulesFindingFilters{
+		Confidence: []*securityhub.NumberFilter{{
+			Eq: aws.Float64(80),
+		}},
+		FirstObservedAt: []*securityhub.DateFilter{{
+			DateRange: &securityhub.DateRange{
+				Unit:  aws.String("DAYS"),
+				Value: aws.Int64(30),
+			},
+		}},
+		ProductName: []*securityhub.StringFilter{{
+			Comparison: aws.String("PREFIX"),
+			Value:      aws.String("Guard"),
+		}},
+		UserDefinedFields: []*securityhub.MapFilter{{
+			Comparison: aws.String("CONTAINS"),
+			Key:        aws.String("team"),
+			Value:      aws.String("platform"),
+		}},
+	}
+
+	d := schema.TestResourceDataRaw(t, tfsecurityhub.ResourceAutomationRule().Schema, map[string]interface{}{})
+
+	if err := d.Set("criteria", tfsecurityhub.FlattenAutomationRulesFindingFilters(apiObject)); err != nil {
+		t.Fatalf("setting criteria: %s", err)
+	}
+
+	for k, want := range map[string]string{
+		"criteria.0.confidence.#":          "1",
+		"criteria.0.first_observed_at.#":   "1",
+		"criteria.0.product_name.#":        "1",
+		"criteria.0.user_defined_fields.#": "1",
+		"criteria.0.aws_account_id.#":      "0",
+	} {
+		if got := fmt.Sprint(d.Get(k)); got != want {
+			t.Errorf("%s: got %s, want %s", k, got, want)
+		}
+	}
+
+	got := tfsecurityhub.ExpandAutomationRulesFindingFilters(d.Get("criteria").([]interface{}))
+
+	if diff := cmp.Diff(got, apiObject, cmpopts.IgnoreUnexported(
+		securityhub.AutomationRulesFindingFilters{},
+		securityhub.DateFilter{},
+		securityhub.DateRange{},
+		securityhub.MapFilter{},
+		securityhub.NumberFilter{},
+		securityhub.StringFilter{},
+	)); diff != "" {
+		t.Errorf("unexpected round trip diff (+wanted, -got): %s", diff)
+	}
+}
+
+func testAccAutomationRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_automation_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "actions.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "actions.*", map[string]string{
+						"type": "FINDING_FIELDS_UPDATE",
+						"finding_fields_update.0.severity.0.label":  "LOW",
+						"finding_fields_update.0.workflow.0.status": "SUPPRESSED",
+						"finding_fields_update.0.note.0.text":       "Suppressed by automation rule",
+						"finding_fields_update.0.note.0.updated_by": "terraform",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.product_name.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "criteria.0.product_name.*", map[string]string{
+						"comparison": "EQUALS",
+						"value":      "GuardDuty",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "is_terminal", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rule_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule_order", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAutomationRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_automation_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsecurityhub.ResourceAutomationRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAutomationRule_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_automation_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule_order", "1"),
+				),
+			},
+			{
+				Config: testAccAutomationRuleConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "actions.*", map[string]string{
+						"finding_fields_update.0.confidence":                 "20",
+						"finding_fields_update.0.criticality":                "10",
+						"finding_fields_update.0.types.#":                    "1",
+						"finding_fields_update.0.user_defined_fields.%":      "1",
+						"finding_fields_update.0.user_defined_fields.reason": "accepted risk",
+						"finding_fields_update.0.verification_state":         "BENIGN_POSITIVE",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.criticality.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.resource_tags.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.updated_at.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated description"),
+					resource.TestCheckResourceAttr(resourceName, "is_terminal", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rule_order", "10"),
+					resource.TestCheckResourceAttr(resourceName, "rule_status", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAutomationRule_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_automation_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAutomationRuleConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAutomationRuleConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAutomationRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_securityhub_automation_rule" {
+				continue
+			}
+
+			_, err := tfsecurityhub.FindAutomationRuleByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if tfawserr.ErrMessageContains(err, securityhub.ErrCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Security Hub Automation Rule (%s) still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAutomationRuleExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn(ctx)
+
+		_, err := tfsecurityhub.FindAutomationRuleByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAutomationRuleConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_automation_rule" "test" {
+  description = "test description"
+  rule_name   = %[1]q
+  rule_order  = 1
+
+  actions {
+    finding_fields_update {
+      severity {
+        label = "LOW"
+      }
+
+      workflow {
+        status = "SUPPRESSED"
+      }
+
+      note {
+        text       = "Suppressed by automation rule"
+        updated_by = "terraform"
+      }
+    }
+  }
+
+  criteria {
+    product_name {
+      comparison = "EQUALS"
+      value      = "GuardDuty"
+    }
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName)
+}
+
+func testAccAutomationRuleConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_automation_rule" "test" {
+  description = "updated description"
+  is_terminal = true
+  rule_name   = %[1]q
+  rule_order  = 10
+  rule_status = "DISABLED"
+
+  actions {
+    finding_fields_update {
+      confidence         = 20
+      criticality        = 10
+      types              = ["Software and Configuration Checks/Industry and Regulatory Standards"]
+      verification_state = "BENIGN_POSITIVE"
+
+      user_defined_fields = {
+        reason = "accepted risk"
+      }
+    }
+  }
+
+  criteria {
+    criticality {
+      gte = "0"
+      lte = "50"
+    }
+
+    resource_tags {
+      comparison = "EQUALS"
+      key        = "Environment"
+      value      = "development"
+    }
+
+    updated_at {
+      date_range {
+        unit  = "DAYS"
+        value = 5
+      }
+    }
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName)
+}
+
+func testAccAutomationRuleConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_automation_rule" "test" {
+  description = "test description"
+  rule_name   = %[1]q
+  rule_order  = 1
+
+  actions {
+    finding_fields_update {
+      workflow {
+        status = "NOTIFIED"
+      }
+    }
+  }
+
+  criteria {
+    severity_label {
+      comparison = "EQUALS"
+      value      = "CRITICAL"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAutomationRuleConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_automation_rule" "test" {
+  description = "test description"
+  rule_name   = %[1]q
+  rule_order  = 1
+
+  actions {
+    finding_fields_update {
+      workflow {
+        status = "NOTIFIED"
+      }
+    }
+  }
+
+  criteria {
+    severity_label {
+      comparison = "EQUALS"
+      value      = "CRITICAL"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
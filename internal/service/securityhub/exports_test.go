@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+// Exports for use in tests only.
+var (
+	ExpandAutomationRulesActions         = expandAutomationRulesActions
+	ExpandAutomationRulesFindingFilters  = expandAutomationRulesFindingFilters
+	FlattenAutomationRulesActions        = flattenAutomationRulesActions
+	FlattenAutomationRulesFindingFilters = flattenAutomationRulesFindingFilters
+	UnprocessedAutomationRuleNotFound    = unprocessedAutomationRuleNotFound
+)
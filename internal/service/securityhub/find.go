@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -97,3 +98,42 @@ func FindStandardsControlByStandardsSubscriptionARNAndStandardsControlARN(ctx co
 
 	return output, nil
 }
+
+func FindAutomationRuleByARN(ctx context.Context, conn *securityhub.SecurityHub, arn string) (*securityhub.AutomationRulesConfig, error) {
+	input := &securityhub.BatchGetAutomationRulesInput{
+		AutomationRulesArns: aws.StringSlice([]string{arn}),
+	}
+
+	output, err := conn.BatchGetAutomationRulesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if len(output.Rules) == 0 || output.Rules[0] == nil {
+		// Throttling, access denied and internal errors are reported as unprocessed rather than as an error.
+		if err := unprocessedAutomationRulesError(slices.Filter(output.UnprocessedAutomationRules, func(v *securityhub.UnprocessedAutomationRule) bool {
+			return !unprocessedAutomationRuleNotFound(v)
+		})); err != nil {
+			return nil, err
+		}
+
+		return nil, &retry.NotFoundError{
+			LastError:   unprocessedAutomationRulesError(output.UnprocessedAutomationRules),
+			LastRequest: input,
+		}
+	}
+
+	return output.Rules[0], nil
+}
@@ -30,6 +30,12 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			"Description": testAccActionTarget_Description,
 			"Name":        testAccActionTarget_Name,
 		},
+		"AutomationRule": {
+			"basic":      testAccAutomationRule_basic,
+			"disappears": testAccAutomationRule_disappears,
+			"update":     testAccAutomationRule_update,
+			"tags":       testAccAutomationRule_tags,
+		},
 		"Insight": {
 			"basic":            testAccInsight_basic,
 			"disappears":       testAccInsight_disappears,
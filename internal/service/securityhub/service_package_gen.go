@@ -37,6 +37,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceActionTarget,
 			TypeName: "aws_securityhub_action_target",
 		},
+		{
+			Factory:  ResourceAutomationRule,
+			TypeName: "aws_securityhub_automation_rule",
+			Name:     "Automation Rule",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceFindingAggregator,
 			TypeName: "aws_securityhub_finding_aggregator",
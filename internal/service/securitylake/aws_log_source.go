@@ -0,0 +1,313 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="AWS Log Source")
+func newResourceAWSLogSource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceAWSLogSource{}, nil
+}
+
+type resourceAWSLogSource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceAWSLogSource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_aws_log_source"
+}
+
+func (r *resourceAWSLogSource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"source": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[awsLogSourceConfigurationData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"accounts": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"regions": schema.SetAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+						},
+						"source_name": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								enum.FrameworkValidate[awstypes.AwsLogSourceName](),
+							},
+						},
+						"source_version": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceAWSLogSource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceAWSLogSourceData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.CreateAwsLogSourceInput{}
+
+	response.Diagnostics.Append(flex.Expand(ctx, &data, input)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	sourceName := string(input.Sources[0].SourceName)
+	output, err := conn.CreateAwsLogSource(ctx, input)
+
+	if err == nil && len(output.Failed) > 0 {
+		err = fmt.Errorf("failed accounts: %s", strings.Join(output.Failed, ", "))
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Lake AWS Log Source (%s)", sourceName), err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(sourceName)
+
+	logSource, err := findAWSLogSourceBySourceName(ctx, conn, sourceName)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake AWS Log Source (%s)", sourceName), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	var source awsLogSourceConfigurationData
+	response.Diagnostics.Append(flex.Flatten(ctx, logSource, &source)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Sources = fwtypes.NewListNestedObjectValueOfPtr(ctx, &source)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceAWSLogSource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceAWSLogSourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	logSource, err := findAWSLogSourceBySourceName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake AWS Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	var source awsLogSourceConfigurationData
+	response.Diagnostics.Append(flex.Flatten(ctx, logSource, &source)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Sources = fwtypes.NewListNestedObjectValueOfPtr(ctx, &source)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceAWSLogSource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Noop.
+}
+
+func (r *resourceAWSLogSource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceAWSLogSourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.DeleteAwsLogSourceInput{}
+
+	response.Diagnostics.Append(flex.Expand(ctx, &data, input)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.DeleteAwsLogSource(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err == nil && len(output.Failed) > 0 {
+		err = fmt.Errorf("failed accounts: %s", strings.Join(output.Failed, ", "))
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake AWS Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type resourceAWSLogSourceData struct {
+	ID      types.String                                                   `tfsdk:"id"`
+	Sources fwtypes.ListNestedObjectValueOf[awsLogSourceConfigurationData] `tfsdk:"source"`
+}
+
+type awsLogSourceConfigurationData struct {
+	Accounts      types.Set    `tfsdk:"accounts"`
+	Regions       types.Set    `tfsdk:"regions"`
+	SourceName    types.String `tfsdk:"source_name"`
+	SourceVersion types.String `tfsdk:"source_version"`
+}
+
+// findAWSLogSourceBySourceName returns the accounts and Regions from which the specified AWS log source is collected.
+func findAWSLogSourceBySourceName(ctx context.Context, conn *securitylake.Client, sourceName string) (*awstypes.AwsLogSourceConfiguration, error) {
+	input := &securitylake.ListLogSourcesInput{
+		Sources: []awstypes.LogSourceResource{
+			&awstypes.LogSourceResourceMemberAwsLogSource{
+				Value: awstypes.AwsLogSourceResource{
+					SourceName: awstypes.AwsLogSourceName(sourceName),
+				},
+			},
+		},
+	}
+
+	var accounts, regions []string
+	var sourceVersion *string
+
+	pages := securitylake.NewListLogSourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, logSource := range page.Sources {
+			for _, v := range logSource.Sources {
+				v, ok := v.(*awstypes.LogSourceResourceMemberAwsLogSource)
+
+				if !ok || string(v.Value.SourceName) != sourceName {
+					continue
+				}
+
+				accounts = appendUnique(accounts, aws.ToString(logSource.Account))
+				regions = appendUnique(regions, aws.ToString(logSource.Region))
+				sourceVersion = v.Value.SourceVersion
+			}
+		}
+	}
+
+	if len(regions) == 0 {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return &awstypes.AwsLogSourceConfiguration{
+		Accounts:      accounts,
+		Regions:       regions,
+		SourceName:    awstypes.AwsLogSourceName(sourceName),
+		SourceVersion: sourceVersion,
+	}, nil
+}
+
+func appendUnique(s []string, v string) []string {
+	if v == "" {
+		return s
+	}
+
+	for _, e := range s {
+		if e == v {
+			return s
+		}
+	}
+
+	return append(s, v)
+}
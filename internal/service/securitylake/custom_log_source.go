@@ -0,0 +1,345 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Custom Log Source")
+func newResourceCustomLogSource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceCustomLogSource{}, nil
+}
+
+type resourceCustomLogSource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceCustomLogSource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_custom_log_source"
+}
+
+func (r *resourceCustomLogSource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"attributes": schema.ListNestedAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customLogSourceAttributesData](ctx),
+				Computed:   true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"crawler_arn": schema.StringAttribute{
+							Computed: true,
+						},
+						"database_arn": schema.StringAttribute{
+							Computed: true,
+						},
+						"table_arn": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"event_classes": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"provider_details": schema.ListNestedAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customLogSourceProviderData](ctx),
+				Computed:   true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"location": schema.StringAttribute{
+							Computed: true,
+						},
+						"role_arn": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"source_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 20),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customLogSourceConfigurationData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"crawler_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[customLogSourceCrawlerConfigurationData](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"role_arn": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"provider_identity": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[awsIdentityData](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"external_id": schema.StringAttribute{
+										Required: true,
+									},
+									"principal": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceCustomLogSource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceCustomLogSourceData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.CreateCustomLogSourceInput{}
+
+	response.Diagnostics.Append(flex.Expand(ctx, &data, input)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateCustomLogSource(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Lake Custom Log Source (%s)", data.SourceName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(flex.Flatten(ctx, output.Source, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.SourceName
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCustomLogSource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceCustomLogSourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	customLogSource, err := findCustomLogSourceBySourceName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Custom Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The log source's configuration and event classes are not returned by the API.
+	response.Diagnostics.Append(flex.Flatten(ctx, customLogSource, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCustomLogSource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Noop.
+}
+
+func (r *resourceCustomLogSource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceCustomLogSourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	_, err := conn.DeleteCustomLogSource(ctx, &securitylake.DeleteCustomLogSourceInput{
+		SourceName:    flex.StringFromFramework(ctx, data.SourceName),
+		SourceVersion: flex.StringFromFramework(ctx, data.SourceVersion),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Custom Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type resourceCustomLogSourceData struct {
+	Attributes    fwtypes.ListNestedObjectValueOf[customLogSourceAttributesData]    `tfsdk:"attributes"`
+	Configuration fwtypes.ListNestedObjectValueOf[customLogSourceConfigurationData] `tfsdk:"configuration"`
+	EventClasses  types.Set                                                         `tfsdk:"event_classes"`
+	ID            types.String                                                      `tfsdk:"id"`
+	Provider      fwtypes.ListNestedObjectValueOf[customLogSourceProviderData]      `tfsdk:"provider_details"`
+	SourceName    types.String                                                      `tfsdk:"source_name"`
+	SourceVersion types.String                                                      `tfsdk:"source_version"`
+}
+
+type customLogSourceAttributesData struct {
+	CrawlerArn  types.String `tfsdk:"crawler_arn"`
+	DatabaseArn types.String `tfsdk:"database_arn"`
+	TableArn    types.String `tfsdk:"table_arn"`
+}
+
+type customLogSourceConfigurationData struct {
+	CrawlerConfiguration fwtypes.ListNestedObjectValueOf[customLogSourceCrawlerConfigurationData] `tfsdk:"crawler_configuration"`
+	ProviderIdentity     fwtypes.ListNestedObjectValueOf[awsIdentityData]                         `tfsdk:"provider_identity"`
+}
+
+type customLogSourceCrawlerConfigurationData struct {
+	RoleArn types.String `tfsdk:"role_arn"`
+}
+
+type customLogSourceProviderData struct {
+	Location types.String `tfsdk:"location"`
+	RoleArn  types.String `tfsdk:"role_arn"`
+}
+
+type awsIdentityData struct {
+	ExternalId types.String `tfsdk:"external_id"`
+	Principal  types.String `tfsdk:"principal"`
+}
+
+func findCustomLogSourceBySourceName(ctx context.Context, conn *securitylake.Client, sourceName string) (*awstypes.CustomLogSourceResource, error) {
+	input := &securitylake.ListLogSourcesInput{
+		Sources: []awstypes.LogSourceResource{
+			&awstypes.LogSourceResourceMemberCustomLogSource{
+				Value: awstypes.CustomLogSourceResource{
+					SourceName: aws.String(sourceName),
+				},
+			},
+		},
+	}
+
+	pages := securitylake.NewListLogSourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, logSource := range page.Sources {
+			for _, v := range logSource.Sources {
+				if v, ok := v.(*awstypes.LogSourceResourceMemberCustomLogSource); ok && aws.ToString(v.Value.SourceName) == sourceName {
+					return &v.Value, nil
+				}
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
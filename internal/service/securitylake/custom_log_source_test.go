@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecuritylake "github.com/hashicorp/terraform-provider-aws/internal/service/securitylake"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccCustomLogSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var customLogSource awstypes.CustomLogSourceResource
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceName := sdkacctest.RandString(10)
+	resourceName := "aws_securitylake_custom_log_source.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLake),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomLogSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomLogSourceConfig_basic(rName, sourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomLogSourceExists(ctx, resourceName, &customLogSource),
+					resource.TestCheckResourceAttr(resourceName, "attributes.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "attributes.0.crawler_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "attributes.0.database_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "attributes.0.table_arn"),
+					resource.TestCheckResourceAttr(resourceName, "provider_details.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "provider_details.0.location"),
+					resource.TestCheckResourceAttrSet(resourceName, "provider_details.0.role_arn"),
+					resource.TestCheckResourceAttr(resourceName, "source_name", sourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "source_version"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"configuration", "event_classes"},
+			},
+		},
+	})
+}
+
+func testAccCustomLogSource_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var customLogSource awstypes.CustomLogSourceResource
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceName := sdkacctest.RandString(10)
+	resourceName := "aws_securitylake_custom_log_source.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLake),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomLogSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomLogSourceConfig_basic(rName, sourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomLogSourceExists(ctx, resourceName, &customLogSource),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfsecuritylake.ResourceCustomLogSource, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCustomLogSourceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_securitylake_custom_log_source" {
+				continue
+			}
+
+			_, err := tfsecuritylake.FindCustomLogSourceBySourceName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Security Lake Custom Log Source %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCustomLogSourceExists(ctx context.Context, n string, v *awstypes.CustomLogSourceResource) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeClient(ctx)
+
+		output, err := tfsecuritylake.FindCustomLogSourceBySourceName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCustomLogSourceConfig_basic(rName, sourceName string) string {
+	return acctest.ConfigCompose(testAccDataLakeConfig_basic(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_iam_role" "crawler" {
+  name = "%[1]s-crawler"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "crawler" {
+  role       = aws_iam_role.crawler.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSGlueServiceRole"
+}
+
+resource "aws_securitylake_custom_log_source" "test" {
+  source_name    = %[2]q
+  source_version = "1.0"
+  event_classes  = ["FILE_ACTIVITY"]
+
+  configuration {
+    crawler_configuration {
+      role_arn = aws_iam_role.crawler.arn
+    }
+
+    provider_identity {
+      external_id = "%[1]s-external-id"
+      principal   = data.aws_caller_identity.current.account_id
+    }
+  }
+
+  depends_on = [aws_securitylake_data_lake.test, aws_iam_role_policy_attachment.crawler]
+}
+`, rName, sourceName))
+}
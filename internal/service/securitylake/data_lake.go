@@ -0,0 +1,562 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Data Lake")
+// @Tags(identifierAttribute="arn")
+func newResourceDataLake(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceDataLake{}
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	// The KMS key ID reported for data lakes that use S3 managed encryption.
+	dataLakeS3ManagedKeyID = "S3_MANAGED_KEY"
+)
+
+type resourceDataLake struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceDataLake) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_data_lake"
+}
+
+func (r *resourceDataLake) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"meta_store_manager_role_arn": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"s3_bucket_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataLakeConfigurationData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"region": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"encryption_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataLakeEncryptionConfigurationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"kms_key_id": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"lifecycle_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataLakeLifecycleConfigurationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"expiration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[dataLakeLifecycleExpirationData](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"days": schema.Int64Attribute{
+													Optional: true,
+												},
+											},
+										},
+									},
+									"transition": schema.SetNestedBlock{
+										CustomType: fwtypes.NewSetNestedObjectTypeOf[dataLakeLifecycleTransitionData](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"days": schema.Int64Attribute{
+													Optional: true,
+												},
+												"storage_class": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"replication_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataLakeReplicationConfigurationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"regions": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"role_arn": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceDataLake) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceDataLakeData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.CreateDataLakeInput{}
+
+	response.Diagnostics.Append(flex.Expand(ctx, &data, input)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateDataLake(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Security Lake Data Lake", err.Error())
+
+		return
+	}
+
+	data.DataLakeArn = flex.StringToFramework(ctx, output.DataLakes[0].DataLakeArn)
+	data.ID = data.DataLakeArn
+
+	dataLake, err := waitDataLakeCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Data Lake (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.S3BucketArn = flex.StringToFramework(ctx, dataLake.S3BucketArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDataLake) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceDataLakeData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	dataLake, err := findDataLakeByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Data Lake (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	var configuration dataLakeConfigurationData
+	response.Diagnostics.Append(flex.Flatten(ctx, dataLake, &configuration)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// S3 managed encryption is reported as a pseudo KMS key ID.
+	// Only surface it if it was explicitly configured.
+	if v := dataLake.EncryptionConfiguration; v != nil && aws.ToString(v.KmsKeyId) == dataLakeS3ManagedKeyID {
+		if !data.hasEncryptionConfiguration(ctx) {
+			configuration.EncryptionConfiguration = fwtypes.NewListNestedObjectValueOfNull[dataLakeEncryptionConfigurationData](ctx)
+		}
+	}
+
+	data.Configurations = fwtypes.NewListNestedObjectValueOfPtr(ctx, &configuration)
+	data.DataLakeArn = flex.StringToFramework(ctx, dataLake.DataLakeArn)
+	data.S3BucketArn = flex.StringToFramework(ctx, dataLake.S3BucketArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDataLake) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceDataLakeData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	if !new.Configurations.Equal(old.Configurations) {
+		input := &securitylake.UpdateDataLakeInput{}
+
+		response.Diagnostics.Append(flex.Expand(ctx, &new, input)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateDataLake(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Security Lake Data Lake (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitDataLakeUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Data Lake (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceDataLake) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceDataLakeData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	region, err := regionFromARN(data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	_, err = conn.DeleteDataLake(ctx, &securitylake.DeleteDataLakeInput{
+		Regions: []string{region},
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Data Lake (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err = waitDataLakeDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Data Lake (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceDataLake) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type resourceDataLakeData struct {
+	Configurations          fwtypes.ListNestedObjectValueOf[dataLakeConfigurationData] `tfsdk:"configuration"`
+	DataLakeArn             types.String                                               `tfsdk:"arn"`
+	ID                      types.String                                               `tfsdk:"id"`
+	MetaStoreManagerRoleArn types.String                                               `tfsdk:"meta_store_manager_role_arn"`
+	S3BucketArn             types.String                                               `tfsdk:"s3_bucket_arn"`
+	Tags                    types.Map                                                  `tfsdk:"tags"`
+	TagsAll                 types.Map                                                  `tfsdk:"tags_all"`
+	Timeouts                timeouts.Value                                             `tfsdk:"timeouts"`
+}
+
+type dataLakeConfigurationData struct {
+	EncryptionConfiguration  fwtypes.ListNestedObjectValueOf[dataLakeEncryptionConfigurationData]  `tfsdk:"encryption_configuration"`
+	LifecycleConfiguration   fwtypes.ListNestedObjectValueOf[dataLakeLifecycleConfigurationData]   `tfsdk:"lifecycle_configuration"`
+	Region                   types.String                                                          `tfsdk:"region"`
+	ReplicationConfiguration fwtypes.ListNestedObjectValueOf[dataLakeReplicationConfigurationData] `tfsdk:"replication_configuration"`
+}
+
+type dataLakeEncryptionConfigurationData struct {
+	KmsKeyId types.String `tfsdk:"kms_key_id"`
+}
+
+type dataLakeLifecycleConfigurationData struct {
+	Expiration  fwtypes.ListNestedObjectValueOf[dataLakeLifecycleExpirationData] `tfsdk:"expiration"`
+	Transitions fwtypes.SetNestedObjectValueOf[dataLakeLifecycleTransitionData]  `tfsdk:"transition"`
+}
+
+type dataLakeLifecycleExpirationData struct {
+	Days types.Int64 `tfsdk:"days"`
+}
+
+type dataLakeLifecycleTransitionData struct {
+	Days         types.Int64  `tfsdk:"days"`
+	StorageClass types.String `tfsdk:"storage_class"`
+}
+
+type dataLakeReplicationConfigurationData struct {
+	Regions types.Set    `tfsdk:"regions"`
+	RoleArn types.String `tfsdk:"role_arn"`
+}
+
+func (data *resourceDataLakeData) hasEncryptionConfiguration(ctx context.Context) bool {
+	ptr, diags := data.Configurations.ToObjectPtr(ctx)
+
+	if diags.HasError() {
+		return false
+	}
+
+	configuration, ok := ptr.(*dataLakeConfigurationData)
+
+	return ok && configuration != nil && len(configuration.EncryptionConfiguration.Elements()) > 0
+}
+
+func regionFromARN(s string) (string, error) {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return "", fmt.Errorf("parsing ARN (%s): %w", s, err)
+	}
+
+	return v.Region, nil
+}
+
+func findDataLakeByARN(ctx context.Context, conn *securitylake.Client, arn string) (*awstypes.DataLakeResource, error) {
+	region, err := regionFromARN(arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &securitylake.ListDataLakesInput{
+		Regions: []string{region},
+	}
+
+	output, err := conn.ListDataLakes(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.DataLakes {
+		if aws.ToString(v.DataLakeArn) == arn {
+			v := v
+
+			return &v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func statusDataLakeCreate(ctx context.Context, conn *securitylake.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDataLakeByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.CreateStatus), nil
+	}
+}
+
+func statusDataLakeUpdate(ctx context.Context, conn *securitylake.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDataLakeByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.UpdateStatus == nil {
+			return nil, "", nil
+		}
+
+		return output, string(output.UpdateStatus.Status), nil
+	}
+}
+
+// dataLakeUpdateStatusReason returns the reason for a data lake update failure, if any.
+func dataLakeUpdateStatusReason(output interface{}) string {
+	if v, ok := output.(*awstypes.DataLakeResource); ok && v.UpdateStatus != nil && v.UpdateStatus.Exception != nil {
+		return aws.ToString(v.UpdateStatus.Exception.Reason)
+	}
+
+	return ""
+}
+
+func waitDataLakeCreated(ctx context.Context, conn *securitylake.Client, arn string, timeout time.Duration) (*awstypes.DataLakeResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DataLakeStatusInitialized, awstypes.DataLakeStatusPending),
+		Target:     enum.Slice(awstypes.DataLakeStatusCompleted),
+		Refresh:    statusDataLakeCreate(ctx, conn, arn),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStatus(ctx, stateConf)
+
+	if output, ok := outputRaw.(*awstypes.DataLakeResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataLakeUpdated(ctx context.Context, conn *securitylake.Client, arn string, timeout time.Duration) (*awstypes.DataLakeResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DataLakeStatusInitialized, awstypes.DataLakeStatusPending),
+		Target:     enum.Slice(awstypes.DataLakeStatusCompleted),
+		Refresh:    statusDataLakeUpdate(ctx, conn, arn),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStatus(ctx, stateConf, tfresource.WithStatusReasonFunc(dataLakeUpdateStatusReason))
+
+	if output, ok := outputRaw.(*awstypes.DataLakeResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataLakeDeleted(ctx context.Context, conn *securitylake.Client, arn string, timeout time.Duration) (*awstypes.DataLakeResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DataLakeStatusInitialized, awstypes.DataLakeStatusPending, awstypes.DataLakeStatusCompleted),
+		Target:     []string{},
+		Refresh:    statusDataLakeCreate(ctx, conn, arn),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStatus(ctx, stateConf)
+
+	if output, ok := outputRaw.(*awstypes.DataLakeResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
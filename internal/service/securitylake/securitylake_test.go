@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// Security Lake supports a single data lake per Region, so all acceptance tests run serially.
+func TestAccSecurityLake_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"AWSLogSource": {
+			"basic":      testAccAWSLogSource_basic,
+			"disappears": testAccAWSLogSource_disappears,
+		},
+		"CustomLogSource": {
+			"basic":      testAccCustomLogSource_basic,
+			"disappears": testAccCustomLogSource_disappears,
+		},
+		"DataLake": {
+			"basic":      testAccDataLake_basic,
+			"disappears": testAccDataLake_disappears,
+			"lifecycle":  testAccDataLake_lifecycle,
+			"tags":       testAccDataLake_tags,
+		},
+		"Subscriber": {
+			"basic":      testAccSubscriber_basic,
+			"disappears": testAccSubscriber_disappears,
+			"tags":       testAccSubscriber_tags,
+			"update":     testAccSubscriber_update,
+		},
+		"SubscriberNotification": {
+			"sqs":   testAccSubscriberNotification_sqs,
+			"https": testAccSubscriberNotification_https,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
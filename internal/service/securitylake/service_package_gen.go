@@ -19,7 +19,34 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newResourceAWSLogSource,
+			Name:    "AWS Log Source",
+		},
+		{
+			Factory: newResourceCustomLogSource,
+			Name:    "Custom Log Source",
+		},
+		{
+			Factory: newResourceDataLake,
+			Name:    "Data Lake",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory: newResourceSubscriber,
+			Name:    "Subscriber",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory: newResourceSubscriberNotification,
+			Name:    "Subscriber Notification",
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
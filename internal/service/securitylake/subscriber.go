@@ -0,0 +1,651 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Subscriber")
+// @Tags(identifierAttribute="arn")
+func newResourceSubscriber(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceSubscriber{}
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type resourceSubscriber struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceSubscriber) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_subscriber"
+}
+
+func (r *resourceSubscriber) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	logSourceResourceBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[logSourceResourceData](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"source_name": schema.StringAttribute{
+						Required: true,
+					},
+					"source_version": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_types": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(enum.FrameworkValidate[awstypes.AccessType]()),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"resource_share_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_share_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"s3_bucket_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscriber_description": schema.StringAttribute{
+				Optional: true,
+			},
+			"subscriber_endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscriber_name": schema.StringAttribute{
+				Required: true,
+			},
+			"subscriber_status": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"source": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[subscriberSourceData](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"aws_log_source_resource":    logSourceResourceBlock(),
+						"custom_log_source_resource": logSourceResourceBlock(),
+					},
+				},
+			},
+			"subscriber_identity": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[awsIdentityData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"external_id": schema.StringAttribute{
+							Required: true,
+						},
+						"principal": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceSubscriber) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceSubscriberData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.CreateSubscriberInput{
+		AccessTypes:           expandAccessTypes(ctx, data.AccessTypes),
+		SubscriberDescription: flex.StringFromFramework(ctx, data.SubscriberDescription),
+		SubscriberName:        flex.StringFromFramework(ctx, data.SubscriberName),
+		Tags:                  getTagsIn(ctx),
+	}
+
+	sources, diags := expandSubscriberSources(ctx, data.Sources)
+	response.Diagnostics.Append(diags...)
+
+	subscriberIdentity, diags := expandAWSIdentity(ctx, data.SubscriberIdentity)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.Sources = sources
+	input.SubscriberIdentity = subscriberIdentity
+
+	output, err := conn.CreateSubscriber(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Lake Subscriber (%s)", data.SubscriberName.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ID = flex.StringToFramework(ctx, output.Subscriber.SubscriberId)
+
+	subscriber, err := waitSubscriberCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Subscriber (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, subscriber)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSubscriber) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceSubscriberData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	subscriber, err := findSubscriberByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Subscriber (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, subscriber)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSubscriber) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceSubscriberData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	if !new.Sources.Equal(old.Sources) ||
+		!new.SubscriberDescription.Equal(old.SubscriberDescription) ||
+		!new.SubscriberIdentity.Equal(old.SubscriberIdentity) ||
+		!new.SubscriberName.Equal(old.SubscriberName) {
+		input := &securitylake.UpdateSubscriberInput{
+			SubscriberDescription: flex.StringFromFramework(ctx, new.SubscriberDescription),
+			SubscriberId:          flex.StringFromFramework(ctx, new.ID),
+			SubscriberName:        flex.StringFromFramework(ctx, new.SubscriberName),
+		}
+
+		sources, diags := expandSubscriberSources(ctx, new.Sources)
+		response.Diagnostics.Append(diags...)
+
+		subscriberIdentity, diags := expandAWSIdentity(ctx, new.SubscriberIdentity)
+		response.Diagnostics.Append(diags...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.Sources = sources
+		input.SubscriberIdentity = subscriberIdentity
+
+		output, err := conn.UpdateSubscriber(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Security Lake Subscriber (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.refreshFromOutput(ctx, output.Subscriber)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.SubscriberStatus = old.SubscriberStatus
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceSubscriber) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceSubscriberData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	_, err := conn.DeleteSubscriber(ctx, &securitylake.DeleteSubscriberInput{
+		SubscriberId: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Subscriber (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceSubscriber) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type resourceSubscriberData struct {
+	AccessTypes           types.Set                                            `tfsdk:"access_types"`
+	ARN                   types.String                                         `tfsdk:"arn"`
+	ID                    types.String                                         `tfsdk:"id"`
+	ResourceShareArn      types.String                                         `tfsdk:"resource_share_arn"`
+	ResourceShareName     types.String                                         `tfsdk:"resource_share_name"`
+	RoleArn               types.String                                         `tfsdk:"role_arn"`
+	S3BucketArn           types.String                                         `tfsdk:"s3_bucket_arn"`
+	Sources               fwtypes.SetNestedObjectValueOf[subscriberSourceData] `tfsdk:"source"`
+	SubscriberDescription types.String                                         `tfsdk:"subscriber_description"`
+	SubscriberEndpoint    types.String                                         `tfsdk:"subscriber_endpoint"`
+	SubscriberIdentity    fwtypes.ListNestedObjectValueOf[awsIdentityData]     `tfsdk:"subscriber_identity"`
+	SubscriberName        types.String                                         `tfsdk:"subscriber_name"`
+	SubscriberStatus      types.String                                         `tfsdk:"subscriber_status"`
+	Tags                  types.Map                                            `tfsdk:"tags"`
+	TagsAll               types.Map                                            `tfsdk:"tags_all"`
+	Timeouts              timeouts.Value                                       `tfsdk:"timeouts"`
+}
+
+type subscriberSourceData struct {
+	AWSLogSourceResource    fwtypes.ListNestedObjectValueOf[logSourceResourceData] `tfsdk:"aws_log_source_resource"`
+	CustomLogSourceResource fwtypes.ListNestedObjectValueOf[logSourceResourceData] `tfsdk:"custom_log_source_resource"`
+}
+
+type logSourceResourceData struct {
+	SourceName    types.String `tfsdk:"source_name"`
+	SourceVersion types.String `tfsdk:"source_version"`
+}
+
+func (data *resourceSubscriberData) refreshFromOutput(ctx context.Context, output *awstypes.SubscriberResource) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if output == nil {
+		return diags
+	}
+
+	data.AccessTypes = flattenAccessTypes(ctx, output.AccessTypes)
+	data.ARN = flex.StringToFramework(ctx, output.SubscriberArn)
+	data.ID = flex.StringToFramework(ctx, output.SubscriberId)
+	data.ResourceShareArn = flex.StringToFramework(ctx, output.ResourceShareArn)
+	data.ResourceShareName = flex.StringToFramework(ctx, output.ResourceShareName)
+	data.RoleArn = flex.StringToFramework(ctx, output.RoleArn)
+	data.S3BucketArn = flex.StringToFramework(ctx, output.S3BucketArn)
+	data.SubscriberDescription = flex.StringToFramework(ctx, output.SubscriberDescription)
+	data.SubscriberEndpoint = flex.StringToFramework(ctx, output.SubscriberEndpoint)
+	data.SubscriberName = flex.StringToFramework(ctx, output.SubscriberName)
+	data.SubscriberStatus = flex.StringValueToFramework(ctx, output.SubscriberStatus)
+
+	if v := output.SubscriberIdentity; v != nil {
+		var identity awsIdentityData
+		diags.Append(flex.Flatten(ctx, v, &identity)...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		data.SubscriberIdentity = fwtypes.NewListNestedObjectValueOfPtr(ctx, &identity)
+	}
+	data.Sources = flattenSubscriberSources(ctx, output.Sources)
+
+	return diags
+}
+
+func expandAccessTypes(ctx context.Context, tfSet types.Set) []awstypes.AccessType {
+	if tfSet.IsNull() || tfSet.IsUnknown() {
+		return nil
+	}
+
+	var apiObjects []awstypes.AccessType
+
+	for _, v := range flex.ExpandFrameworkStringValueSet(ctx, tfSet) {
+		apiObjects = append(apiObjects, awstypes.AccessType(v))
+	}
+
+	return apiObjects
+}
+
+func flattenAccessTypes(ctx context.Context, apiObjects []awstypes.AccessType) types.Set {
+	var vs []string
+
+	for _, v := range apiObjects {
+		vs = append(vs, string(v))
+	}
+
+	return flex.FlattenFrameworkStringValueSet(ctx, vs)
+}
+
+func expandAWSIdentity(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[awsIdentityData]) (*awstypes.AwsIdentity, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if tfList.IsNull() || tfList.IsUnknown() || len(tfList.Elements()) == 0 {
+		return nil, diags
+	}
+
+	ptr, d := tfList.ToObjectPtr(ctx)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tfObject, ok := ptr.(*awsIdentityData)
+
+	if !ok || tfObject == nil {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.AwsIdentity{}
+	diags.Append(flex.Expand(ctx, tfObject, apiObject)...)
+
+	return apiObject, diags
+}
+
+func expandSubscriberSources(ctx context.Context, tfSet fwtypes.SetNestedObjectValueOf[subscriberSourceData]) ([]awstypes.LogSourceResource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	slice, d := tfSet.ToObjectSlice(ctx)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tfList, ok := slice.([]*subscriberSourceData)
+
+	if !ok {
+		return nil, diags
+	}
+
+	var apiObjects []awstypes.LogSourceResource
+
+	for _, tfObject := range tfList {
+		if tfObject == nil {
+			continue
+		}
+
+		awsLogSource, d := expandLogSourceResource(ctx, tfObject.AWSLogSourceResource)
+		diags.Append(d...)
+
+		customLogSource, d := expandLogSourceResource(ctx, tfObject.CustomLogSourceResource)
+		diags.Append(d...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		switch {
+		case awsLogSource != nil && customLogSource == nil:
+			apiObjects = append(apiObjects, &awstypes.LogSourceResourceMemberAwsLogSource{
+				Value: awstypes.AwsLogSourceResource{
+					SourceName:    awstypes.AwsLogSourceName(aws.ToString(awsLogSource.SourceName)),
+					SourceVersion: awsLogSource.SourceVersion,
+				},
+			})
+		case customLogSource != nil && awsLogSource == nil:
+			apiObjects = append(apiObjects, &awstypes.LogSourceResourceMemberCustomLogSource{
+				Value: awstypes.CustomLogSourceResource{
+					SourceName:    customLogSource.SourceName,
+					SourceVersion: customLogSource.SourceVersion,
+				},
+			})
+		default:
+			diags.AddError("invalid source", `exactly one of "aws_log_source_resource" or "custom_log_source_resource" must be specified`)
+
+			return nil, diags
+		}
+	}
+
+	return apiObjects, diags
+}
+
+// logSourceResource is the common shape of the AWS and custom log source members of the LogSourceResource union.
+type logSourceResource struct {
+	SourceName    *string
+	SourceVersion *string
+}
+
+func expandLogSourceResource(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[logSourceResourceData]) (*logSourceResource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if tfList.IsNull() || tfList.IsUnknown() || len(tfList.Elements()) == 0 {
+		return nil, diags
+	}
+
+	ptr, d := tfList.ToObjectPtr(ctx)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tfObject, ok := ptr.(*logSourceResourceData)
+
+	if !ok || tfObject == nil {
+		return nil, diags
+	}
+
+	apiObject := &logSourceResource{}
+	diags.Append(flex.Expand(ctx, tfObject, apiObject)...)
+
+	return apiObject, diags
+}
+
+func flattenSubscriberSources(ctx context.Context, apiObjects []awstypes.LogSourceResource) fwtypes.SetNestedObjectValueOf[subscriberSourceData] {
+	var tfList []subscriberSourceData
+
+	for _, apiObject := range apiObjects {
+		tfObject := subscriberSourceData{
+			AWSLogSourceResource:    fwtypes.NewListNestedObjectValueOfValueSlice(ctx, []logSourceResourceData{}),
+			CustomLogSourceResource: fwtypes.NewListNestedObjectValueOfValueSlice(ctx, []logSourceResourceData{}),
+		}
+
+		switch v := apiObject.(type) {
+		case *awstypes.LogSourceResourceMemberAwsLogSource:
+			tfObject.AWSLogSourceResource = fwtypes.NewListNestedObjectValueOfPtr(ctx, &logSourceResourceData{
+				SourceName:    flex.StringValueToFramework(ctx, v.Value.SourceName),
+				SourceVersion: flex.StringToFramework(ctx, v.Value.SourceVersion),
+			})
+		case *awstypes.LogSourceResourceMemberCustomLogSource:
+			tfObject.CustomLogSourceResource = fwtypes.NewListNestedObjectValueOfPtr(ctx, &logSourceResourceData{
+				SourceName:    flex.StringToFramework(ctx, v.Value.SourceName),
+				SourceVersion: flex.StringToFramework(ctx, v.Value.SourceVersion),
+			})
+		default:
+			continue
+		}
+
+		tfList = append(tfList, tfObject)
+	}
+
+	return fwtypes.NewSetNestedObjectValueOfValueSlice(ctx, tfList)
+}
+
+func findSubscriberByID(ctx context.Context, conn *securitylake.Client, id string) (*awstypes.SubscriberResource, error) {
+	input := &securitylake.GetSubscriberInput{
+		SubscriberId: aws.String(id),
+	}
+
+	output, err := conn.GetSubscriber(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Subscriber == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Subscriber, nil
+}
+
+func statusSubscriber(ctx context.Context, conn *securitylake.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findSubscriberByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.SubscriberStatus), nil
+	}
+}
+
+func waitSubscriberCreated(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.SubscriberStatusPending),
+		Target:     enum.Slice(awstypes.SubscriberStatusActive, awstypes.SubscriberStatusReady),
+		Refresh:    statusSubscriber(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStatus(ctx, stateConf)
+
+	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,328 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Subscriber Notification")
+func newResourceSubscriberNotification(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceSubscriberNotification{}, nil
+}
+
+type resourceSubscriberNotification struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceSubscriberNotification) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_subscriber_notification"
+}
+
+func (r *resourceSubscriberNotification) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"endpoint_id": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"subscriber_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[subscriberNotificationConfigurationData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"https_notification_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[httpsNotificationConfigurationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("sqs_notification_configuration")),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"authorization_api_key_name": schema.StringAttribute{
+										Optional: true,
+									},
+									"authorization_api_key_value": schema.StringAttribute{
+										Optional:  true,
+										Sensitive: true,
+									},
+									"endpoint": schema.StringAttribute{
+										Required: true,
+									},
+									"http_method": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											enum.FrameworkValidate[awstypes.HttpMethod](),
+										},
+									},
+									"target_role_arn": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"sqs_notification_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[sqsNotificationConfigurationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSubscriberNotification) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceSubscriberNotificationData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	configuration, diags := expandNotificationConfiguration(ctx, data.Configuration)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &securitylake.CreateSubscriberNotificationInput{
+		Configuration: configuration,
+		SubscriberId:  flex.StringFromFramework(ctx, data.SubscriberID),
+	}
+
+	output, err := conn.CreateSubscriberNotification(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Lake Subscriber Notification (%s)", data.SubscriberID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.EndpointID = flex.StringToFramework(ctx, output.SubscriberEndpoint)
+	data.ID = data.SubscriberID
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSubscriberNotification) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceSubscriberNotificationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	subscriber, err := findSubscriberNotificationBySubscriberID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Subscriber Notification (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The notification configuration is not returned by the API.
+	data.EndpointID = flex.StringToFramework(ctx, subscriber.SubscriberEndpoint)
+	data.SubscriberID = flex.StringToFramework(ctx, subscriber.SubscriberId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSubscriberNotification) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceSubscriberNotificationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	if !new.Configuration.Equal(old.Configuration) {
+		configuration, diags := expandNotificationConfiguration(ctx, new.Configuration)
+		response.Diagnostics.Append(diags...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &securitylake.UpdateSubscriberNotificationInput{
+			Configuration: configuration,
+			SubscriberId:  flex.StringFromFramework(ctx, new.ID),
+		}
+
+		output, err := conn.UpdateSubscriberNotification(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Security Lake Subscriber Notification (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.EndpointID = flex.StringToFramework(ctx, output.SubscriberEndpoint)
+	} else {
+		new.EndpointID = old.EndpointID
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceSubscriberNotification) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceSubscriberNotificationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	_, err := conn.DeleteSubscriberNotification(ctx, &securitylake.DeleteSubscriberNotificationInput{
+		SubscriberId: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Subscriber Notification (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type resourceSubscriberNotificationData struct {
+	Configuration fwtypes.ListNestedObjectValueOf[subscriberNotificationConfigurationData] `tfsdk:"configuration"`
+	EndpointID    types.String                                                             `tfsdk:"endpoint_id"`
+	ID            types.String                                                             `tfsdk:"id"`
+	SubscriberID  types.String                                                             `tfsdk:"subscriber_id"`
+}
+
+type subscriberNotificationConfigurationData struct {
+	HTTPSNotificationConfiguration fwtypes.ListNestedObjectValueOf[httpsNotificationConfigurationData] `tfsdk:"https_notification_configuration"`
+	SQSNotificationConfiguration   fwtypes.ListNestedObjectValueOf[sqsNotificationConfigurationData]   `tfsdk:"sqs_notification_configuration"`
+}
+
+type httpsNotificationConfigurationData struct {
+	AuthorizationApiKeyName  types.String `tfsdk:"authorization_api_key_name"`
+	AuthorizationApiKeyValue types.String `tfsdk:"authorization_api_key_value"`
+	Endpoint                 types.String `tfsdk:"endpoint"`
+	HttpMethod               types.String `tfsdk:"http_method"`
+	TargetRoleArn            types.String `tfsdk:"target_role_arn"`
+}
+
+type sqsNotificationConfigurationData struct{}
+
+func expandNotificationConfiguration(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[subscriberNotificationConfigurationData]) (awstypes.NotificationConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ptr, d := tfList.ToObjectPtr(ctx)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tfObject, ok := ptr.(*subscriberNotificationConfigurationData)
+
+	if !ok || tfObject == nil {
+		return nil, diags
+	}
+
+	if len(tfObject.HTTPSNotificationConfiguration.Elements()) > 0 {
+		ptr, d := tfObject.HTTPSNotificationConfiguration.ToObjectPtr(ctx)
+		diags.Append(d...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.NotificationConfigurationMemberHttpsNotificationConfiguration{}
+		diags.Append(flex.Expand(ctx, ptr, &apiObject.Value)...)
+
+		return apiObject, diags
+	}
+
+	if len(tfObject.SQSNotificationConfiguration.Elements()) > 0 {
+		return &awstypes.NotificationConfigurationMemberSqsNotificationConfiguration{}, diags
+	}
+
+	diags.AddError("invalid configuration", `one of "https_notification_configuration" or "sqs_notification_configuration" must be specified`)
+
+	return nil, diags
+}
+
+func findSubscriberNotificationBySubscriberID(ctx context.Context, conn *securitylake.Client, subscriberID string) (*awstypes.SubscriberResource, error) {
+	output, err := findSubscriberByID(ctx, conn, subscriberID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.SubscriberEndpoint == nil {
+		return nil, tfresource.NewEmptyResultError(subscriberID)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecuritylake "github.com/hashicorp/terraform-provider-aws/internal/service/securitylake"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccSubscriber_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var subscriber awstypes.SubscriberResource
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securitylake_subscriber.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLake),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubscriberDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriberConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriberExists(ctx, resourceName, &subscriber),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "securitylake", regexp.MustCompile(`subscriber/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "access_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "access_types.*", "S3"),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "source.*", map[string]string{
+						"aws_log_source_resource.#":             "1",
+						"aws_log_source_resource.0.source_name": "ROUTE53",
+						"custom_log_source_resource.#":          "0",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "subscriber_description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "subscriber_identity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "subscriber_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "subscriber_status"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSubscriber_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var subscriber awstypes.SubscriberResource
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securitylake_subscriber.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLake),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubscriberDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriberConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriberExists(ctx, resourceName, &subscriber),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfsecuritylake.ResourceSubscriber, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccSubscriber_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var subscriber awstypes.SubscriberResource
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securitylake_subscriber.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLake),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubscriberDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriberConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriberExists(ctx, resourceName, &subscriber),
+					resource.TestCheckResourceAttr(resourceName, "subscriber_description", "test"),
+				),
+			},
+			{
+				Config: testAccSubscriberConfig_basic(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriberExists(ctx, resourceName, &subscriber),
+					resource.TestCheckResourceAttr(resourceName, "subscriber_description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSubscriber_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var subscriber awstypes.SubscriberResource
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securitylake_subscriber.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLake),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubscriberDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriberConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriberExists(ctx, resourceName, &subscriber),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccSubscriberConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriberExists(ctx, resourceName, &subscriber),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccSubscriberConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriberExists(ctx, resourceName, &subscriber),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSubscriberDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_securitylake_subscriber" {
+				continue
+			}
+
+			_, err := tfsecuritylake.FindSubscriberByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Security Lake Subscriber %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSubscriberExists(ctx context.Context, n string, v *awstypes.SubscriberResource) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeClient(ctx)
+
+		output, err := tfsecuritylake.FindSubscriberByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSubscriberConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccAWSLogSourceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_securitylake_subscriber" "test" {
+  subscriber_name        = %[1]q
+  subscriber_description = %[2]q
+  access_types           = ["S3"]
+
+  source {
+    aws_log_source_resource {
+      source_name = aws_securitylake_aws_log_source.test.source[0].source_name
+    }
+  }
+
+  subscriber_identity {
+    external_id = "%[1]s-external-id"
+    principal   = data.aws_caller_identity.current.account_id
+  }
+}
+`, rName, description))
+}
+
+func testAccSubscriberConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccAWSLogSourceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_securitylake_subscriber" "test" {
+  subscriber_name = %[1]q
+  access_types    = ["S3"]
+
+  source {
+    aws_log_source_resource {
+      source_name = aws_securitylake_aws_log_source.test.source[0].source_name
+    }
+  }
+
+  subscriber_identity {
+    external_id = "%[1]s-external-id"
+    principal   = data.aws_caller_identity.current.account_id
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccSubscriberConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccAWSLogSourceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_securitylake_subscriber" "test" {
+  subscriber_name = %[1]q
+  access_types    = ["S3"]
+
+  source {
+    aws_log_source_resource {
+      source_name = aws_securitylake_aws_log_source.test.source[0].source_name
+    }
+  }
+
+  subscriber_identity {
+    external_id = "%[1]s-external-id"
+    principal   = data.aws_caller_identity.current.account_id
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
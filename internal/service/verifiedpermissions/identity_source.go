@@ -0,0 +1,354 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Identity Source")
+func newResourceIdentitySource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceIdentitySource{}, nil
+}
+
+type resourceIdentitySource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceIdentitySource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_identity_source"
+}
+
+func (r *resourceIdentitySource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"identity_source_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_entity_type": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[identitySourceConfigurationData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"cognito_user_pool_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[cognitoUserPoolConfigurationData](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"client_ids": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"user_pool_arn": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceIdentitySource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	configuration, diags := data.cognitoUserPoolConfiguration(ctx)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &verifiedpermissions.CreateIdentitySourceInput{
+		ClientToken:         aws.String(id.UniqueId()),
+		Configuration:       &awstypes.ConfigurationMemberCognitoUserPoolConfiguration{Value: configuration},
+		PolicyStoreId:       flex.StringFromFramework(ctx, data.PolicyStoreId),
+		PrincipalEntityType: flex.StringFromFramework(ctx, data.PrincipalEntityType),
+	}
+
+	output, err := conn.CreateIdentitySource(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Verified Permissions Identity Source (%s)", data.PolicyStoreId.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.IdentitySourceId = flex.StringToFramework(ctx, output.IdentitySourceId)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceIdentitySource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	output, err := findIdentitySourceByTwoPartKey(ctx, conn, data.PolicyStoreId.ValueString(), data.IdentitySourceId.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Permissions Identity Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	var cognitoUserPoolConfiguration cognitoUserPoolConfigurationData
+	response.Diagnostics.Append(flex.Flatten(ctx, output.Details, &cognitoUserPoolConfiguration)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Configuration = fwtypes.NewListNestedObjectValueOfPtr(ctx, &identitySourceConfigurationData{
+		CognitoUserPoolConfiguration: fwtypes.NewListNestedObjectValueOfPtr(ctx, &cognitoUserPoolConfiguration),
+	})
+	data.PrincipalEntityType = flex.StringToFramework(ctx, output.PrincipalEntityType)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceIdentitySource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	if !new.Configuration.Equal(old.Configuration) || !new.PrincipalEntityType.Equal(old.PrincipalEntityType) {
+		configuration, diags := new.cognitoUserPoolConfiguration(ctx)
+		response.Diagnostics.Append(diags...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &verifiedpermissions.UpdateIdentitySourceInput{
+			IdentitySourceId: flex.StringFromFramework(ctx, new.IdentitySourceId),
+			PolicyStoreId:    flex.StringFromFramework(ctx, new.PolicyStoreId),
+			UpdateConfiguration: &awstypes.UpdateConfigurationMemberCognitoUserPoolConfiguration{
+				Value: awstypes.UpdateCognitoUserPoolConfiguration{
+					ClientIds:   configuration.ClientIds,
+					UserPoolArn: configuration.UserPoolArn,
+				},
+			},
+			PrincipalEntityType: flex.StringFromFramework(ctx, new.PrincipalEntityType),
+		}
+
+		_, err := conn.UpdateIdentitySource(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Verified Permissions Identity Source (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceIdentitySource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	_, err := conn.DeleteIdentitySource(ctx, &verifiedpermissions.DeleteIdentitySourceInput{
+		IdentitySourceId: flex.StringFromFramework(ctx, data.IdentitySourceId),
+		PolicyStoreId:    flex.StringFromFramework(ctx, data.PolicyStoreId),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Verified Permissions Identity Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type resourceIdentitySourceData struct {
+	Configuration       fwtypes.ListNestedObjectValueOf[identitySourceConfigurationData] `tfsdk:"configuration"`
+	ID                  types.String                                                     `tfsdk:"id"`
+	IdentitySourceId    types.String                                                     `tfsdk:"identity_source_id"`
+	PolicyStoreId       types.String                                                     `tfsdk:"policy_store_id"`
+	PrincipalEntityType types.String                                                     `tfsdk:"principal_entity_type"`
+}
+
+type identitySourceConfigurationData struct {
+	CognitoUserPoolConfiguration fwtypes.ListNestedObjectValueOf[cognitoUserPoolConfigurationData] `tfsdk:"cognito_user_pool_configuration"`
+}
+
+type cognitoUserPoolConfigurationData struct {
+	ClientIds   types.Set    `tfsdk:"client_ids"`
+	UserPoolArn types.String `tfsdk:"user_pool_arn"`
+}
+
+const (
+	identitySourceResourceIDSeparator = ":"
+)
+
+func (data *resourceIdentitySourceData) InitFromID() error {
+	parts := strings.Split(data.ID.ValueString(), identitySourceResourceIDSeparator)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("unexpected format for ID (%[1]s), expected policy-store-id%[2]sidentity-source-id", data.ID.ValueString(), identitySourceResourceIDSeparator)
+	}
+
+	data.PolicyStoreId = types.StringValue(parts[0])
+	data.IdentitySourceId = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *resourceIdentitySourceData) setID() {
+	data.ID = types.StringValue(strings.Join([]string{data.PolicyStoreId.ValueString(), data.IdentitySourceId.ValueString()}, identitySourceResourceIDSeparator))
+}
+
+// cognitoUserPoolConfiguration returns the API representation of the configured Amazon Cognito user pool.
+// The pinned AWS SDK only supports Amazon Cognito user pool identity sources.
+func (data *resourceIdentitySourceData) cognitoUserPoolConfiguration(ctx context.Context) (awstypes.CognitoUserPoolConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var apiObject awstypes.CognitoUserPoolConfiguration
+
+	ptr, d := data.Configuration.ToObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return apiObject, diags
+	}
+
+	configuration, ok := ptr.(*identitySourceConfigurationData)
+	if !ok || configuration == nil {
+		return apiObject, diags
+	}
+
+	ptr, d = configuration.CognitoUserPoolConfiguration.ToObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return apiObject, diags
+	}
+
+	diags.Append(flex.Expand(ctx, ptr, &apiObject)...)
+
+	return apiObject, diags
+}
+
+func findIdentitySourceByTwoPartKey(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, identitySourceID string) (*verifiedpermissions.GetIdentitySourceOutput, error) {
+	input := &verifiedpermissions.GetIdentitySourceInput{
+		IdentitySourceId: aws.String(identitySourceID),
+		PolicyStoreId:    aws.String(policyStoreID),
+	}
+
+	output, err := conn.GetIdentitySource(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.IdentitySourceId == nil || output.Details == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
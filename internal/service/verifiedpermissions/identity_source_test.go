@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVerifiedPermissionsIdentitySource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var identitySource verifiedpermissions.GetIdentitySourceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_verifiedpermissions_identity_source.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissions)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissions),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIdentitySourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentitySourceConfig_basic(rName, "User"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentitySourceExists(ctx, resourceName, &identitySource),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_store_id", "aws_verifiedpermissions_policy_store.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity_source_id"),
+					resource.TestCheckResourceAttr(resourceName, "principal_entity_type", "User"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.cognito_user_pool_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.cognito_user_pool_configuration.0.user_pool_arn", "aws_cognito_user_pool.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.cognito_user_pool_configuration.0.client_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIdentitySourceConfig_basic(rName, "Admin"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentitySourceExists(ctx, resourceName, &identitySource),
+					resource.TestCheckResourceAttr(resourceName, "principal_entity_type", "Admin"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsIdentitySource_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var identitySource verifiedpermissions.GetIdentitySourceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_verifiedpermissions_identity_source.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissions)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissions),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIdentitySourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentitySourceConfig_basic(rName, "User"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentitySourceExists(ctx, resourceName, &identitySource),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfverifiedpermissions.ResourceIdentitySource, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIdentitySourceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_verifiedpermissions_identity_source" {
+				continue
+			}
+
+			_, err := tfverifiedpermissions.FindIdentitySourceByTwoPartKey(ctx, conn, rs.Primary.Attributes["policy_store_id"], rs.Primary.Attributes["identity_source_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Verified Permissions Identity Source %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIdentitySourceExists(ctx context.Context, n string, v *verifiedpermissions.GetIdentitySourceOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		output, err := tfverifiedpermissions.FindIdentitySourceByTwoPartKey(ctx, conn, rs.Primary.Attributes["policy_store_id"], rs.Primary.Attributes["identity_source_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccIdentitySourceConfig_basic(rName, principalEntityType string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = "OFF"
+  }
+}
+
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+
+resource "aws_cognito_user_pool_client" "test" {
+  name         = %[1]q
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+
+resource "aws_verifiedpermissions_identity_source" "test" {
+  policy_store_id       = aws_verifiedpermissions_policy_store.test.id
+  principal_entity_type = %[2]q
+
+  configuration {
+    cognito_user_pool_configuration {
+      user_pool_arn = aws_cognito_user_pool.test.arn
+      client_ids    = [aws_cognito_user_pool_client.test.id]
+    }
+  }
+}
+`, rName, principalEntityType)
+}
@@ -0,0 +1,487 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Policy")
+func newResourcePolicy(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourcePolicy{}, nil
+}
+
+type resourcePolicy struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourcePolicy) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_policy"
+}
+
+func (r *resourcePolicy) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	entityIdentifierBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[entityIdentifierData](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"entity_id": schema.StringAttribute{
+					Required: true,
+				},
+				"entity_type": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"policy_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[policyDefinitionData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"static": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[staticPolicyDefinitionData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("static"),
+									path.MatchRelative().AtParent().AtName("template_linked"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrDescription: schema.StringAttribute{
+										Optional: true,
+									},
+									"statement": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"template_linked": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[templateLinkedPolicyDefinitionData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"policy_template_id": schema.StringAttribute{
+										Required: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"principal": entityIdentifierBlock,
+									"resource":  entityIdentifierBlock,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourcePolicy) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourcePolicyData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	definition, diags := expandPolicyDefinition(ctx, data.Definition)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &verifiedpermissions.CreatePolicyInput{
+		ClientToken:   aws.String(id.UniqueId()),
+		Definition:    definition,
+		PolicyStoreId: flex.StringFromFramework(ctx, data.PolicyStoreId),
+	}
+
+	output, err := conn.CreatePolicy(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Verified Permissions Policy (%s)", data.PolicyStoreId.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.PolicyId = flex.StringToFramework(ctx, output.PolicyId)
+	data.PolicyType = flex.StringValueToFramework(ctx, output.PolicyType)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourcePolicy) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourcePolicyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	output, err := findPolicyByTwoPartKey(ctx, conn, data.PolicyStoreId.ValueString(), data.PolicyId.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Permissions Policy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	definition, diags := flattenPolicyDefinition(ctx, output.Definition)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Definition = definition
+	data.PolicyType = flex.StringValueToFramework(ctx, output.PolicyType)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourcePolicy) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourcePolicyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	if !new.Definition.Equal(old.Definition) {
+		definition, diags := expandUpdatePolicyDefinition(ctx, new.Definition)
+		response.Diagnostics.Append(diags...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &verifiedpermissions.UpdatePolicyInput{
+			Definition:    definition,
+			PolicyId:      flex.StringFromFramework(ctx, new.PolicyId),
+			PolicyStoreId: flex.StringFromFramework(ctx, new.PolicyStoreId),
+		}
+
+		_, err := conn.UpdatePolicy(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Verified Permissions Policy (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourcePolicy) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourcePolicyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	_, err := conn.DeletePolicy(ctx, &verifiedpermissions.DeletePolicyInput{
+		PolicyId:      flex.StringFromFramework(ctx, data.PolicyId),
+		PolicyStoreId: flex.StringFromFramework(ctx, data.PolicyStoreId),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Verified Permissions Policy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type resourcePolicyData struct {
+	Definition    fwtypes.ListNestedObjectValueOf[policyDefinitionData] `tfsdk:"definition"`
+	ID            types.String                                          `tfsdk:"id"`
+	PolicyId      types.String                                          `tfsdk:"policy_id"`
+	PolicyStoreId types.String                                          `tfsdk:"policy_store_id"`
+	PolicyType    types.String                                          `tfsdk:"policy_type"`
+}
+
+type policyDefinitionData struct {
+	Static         fwtypes.ListNestedObjectValueOf[staticPolicyDefinitionData]         `tfsdk:"static"`
+	TemplateLinked fwtypes.ListNestedObjectValueOf[templateLinkedPolicyDefinitionData] `tfsdk:"template_linked"`
+}
+
+type staticPolicyDefinitionData struct {
+	Description types.String `tfsdk:"description"`
+	Statement   types.String `tfsdk:"statement"`
+}
+
+type templateLinkedPolicyDefinitionData struct {
+	PolicyTemplateId types.String                                          `tfsdk:"policy_template_id"`
+	Principal        fwtypes.ListNestedObjectValueOf[entityIdentifierData] `tfsdk:"principal"`
+	Resource         fwtypes.ListNestedObjectValueOf[entityIdentifierData] `tfsdk:"resource"`
+}
+
+type entityIdentifierData struct {
+	EntityId   types.String `tfsdk:"entity_id"`
+	EntityType types.String `tfsdk:"entity_type"`
+}
+
+const (
+	policyResourceIDSeparator = ":"
+)
+
+func (data *resourcePolicyData) InitFromID() error {
+	parts := strings.Split(data.ID.ValueString(), policyResourceIDSeparator)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("unexpected format for ID (%[1]s), expected policy-store-id%[2]spolicy-id", data.ID.ValueString(), policyResourceIDSeparator)
+	}
+
+	data.PolicyStoreId = types.StringValue(parts[0])
+	data.PolicyId = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *resourcePolicyData) setID() {
+	data.ID = types.StringValue(strings.Join([]string{data.PolicyStoreId.ValueString(), data.PolicyId.ValueString()}, policyResourceIDSeparator))
+}
+
+func expandPolicyDefinition(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[policyDefinitionData]) (awstypes.PolicyDefinition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ptr, d := tfList.ToObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	data, ok := ptr.(*policyDefinitionData)
+	if !ok || data == nil {
+		return nil, diags
+	}
+
+	if !data.Static.IsNull() {
+		ptr, d := data.Static.ToObjectPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.StaticPolicyDefinition
+		diags.Append(flex.Expand(ctx, ptr, &apiObject)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &awstypes.PolicyDefinitionMemberStatic{Value: apiObject}, diags
+	}
+
+	if !data.TemplateLinked.IsNull() {
+		ptr, d := data.TemplateLinked.ToObjectPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.TemplateLinkedPolicyDefinition
+		diags.Append(flex.Expand(ctx, ptr, &apiObject)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &awstypes.PolicyDefinitionMemberTemplateLinked{Value: apiObject}, diags
+	}
+
+	return nil, diags
+}
+
+func expandUpdatePolicyDefinition(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[policyDefinitionData]) (awstypes.UpdatePolicyDefinition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ptr, d := tfList.ToObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	data, ok := ptr.(*policyDefinitionData)
+	if !ok || data == nil || data.Static.IsNull() {
+		return nil, diags
+	}
+
+	// Only static policies can be updated.
+	ptr, d = data.Static.ToObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var apiObject awstypes.UpdateStaticPolicyDefinition
+	diags.Append(flex.Expand(ctx, ptr, &apiObject)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &awstypes.UpdatePolicyDefinitionMemberStatic{Value: apiObject}, diags
+}
+
+func flattenPolicyDefinition(ctx context.Context, apiObject awstypes.PolicyDefinitionDetail) (fwtypes.ListNestedObjectValueOf[policyDefinitionData], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data := policyDefinitionData{
+		Static:         fwtypes.NewListNestedObjectValueOfNull[staticPolicyDefinitionData](ctx),
+		TemplateLinked: fwtypes.NewListNestedObjectValueOfNull[templateLinkedPolicyDefinitionData](ctx),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.PolicyDefinitionDetailMemberStatic:
+		var static staticPolicyDefinitionData
+		diags.Append(flex.Flatten(ctx, v.Value, &static)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[policyDefinitionData](ctx), diags
+		}
+
+		data.Static = fwtypes.NewListNestedObjectValueOfPtr(ctx, &static)
+
+	case *awstypes.PolicyDefinitionDetailMemberTemplateLinked:
+		var templateLinked templateLinkedPolicyDefinitionData
+		diags.Append(flex.Flatten(ctx, v.Value, &templateLinked)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[policyDefinitionData](ctx), diags
+		}
+
+		data.TemplateLinked = fwtypes.NewListNestedObjectValueOfPtr(ctx, &templateLinked)
+
+	default:
+		return fwtypes.NewListNestedObjectValueOfNull[policyDefinitionData](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtr(ctx, &data), diags
+}
+
+func findPolicyByTwoPartKey(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, policyID string) (*verifiedpermissions.GetPolicyOutput, error) {
+	input := &verifiedpermissions.GetPolicyInput{
+		PolicyId:      aws.String(policyID),
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	output, err := conn.GetPolicy(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PolicyId == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
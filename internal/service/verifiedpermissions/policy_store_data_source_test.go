@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVerifiedPermissionsPolicyStoreDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_verifiedpermissions_policy_store.test"
+	resourceName := "aws_verifiedpermissions_policy_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissions)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissions),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyStoreDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "validation_settings.#", resourceName, "validation_settings.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "validation_settings.0.mode", resourceName, "validation_settings.0.mode"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPolicyStoreDataSourceConfig_basic() string {
+	return `
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = "STRICT"
+  }
+}
+
+data "aws_verifiedpermissions_policy_store" "test" {
+  id = aws_verifiedpermissions_policy_store.test.id
+}
+`
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVerifiedPermissionsPolicyStore_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policystore verifiedpermissions.GetPolicyStoreOutput
+	resourceName := "aws_verifiedpermissions_policy_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissions)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissions),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyStoreConfig_basic("OFF"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyStoreExists(ctx, resourceName, &policystore),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "verifiedpermissions", regexp.MustCompile(`policy-store/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "validation_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "validation_settings.0.mode", "OFF"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicyStore_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policystore verifiedpermissions.GetPolicyStoreOutput
+	resourceName := "aws_verifiedpermissions_policy_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissions)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissions),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyStoreConfig_basic("OFF"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyStoreExists(ctx, resourceName, &policystore),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfverifiedpermissions.ResourcePolicyStore, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicyStore_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policystore verifiedpermissions.GetPolicyStoreOutput
+	resourceName := "aws_verifiedpermissions_policy_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissions)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissions),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyStoreConfig_basic("OFF"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyStoreExists(ctx, resourceName, &policystore),
+					resource.TestCheckResourceAttr(resourceName, "validation_settings.0.mode", "OFF"),
+				),
+			},
+			{
+				Config: testAccPolicyStoreConfig_basic("STRICT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyStoreExists(ctx, resourceName, &policystore),
+					resource.TestCheckResourceAttr(resourceName, "validation_settings.0.mode", "STRICT"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPolicyStoreDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_verifiedpermissions_policy_store" {
+				continue
+			}
+
+			_, err := tfverifiedpermissions.FindPolicyStoreByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Verified Permissions Policy Store %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPolicyStoreExists(ctx context.Context, n string, v *verifiedpermissions.GetPolicyStoreOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		output, err := tfverifiedpermissions.FindPolicyStoreByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPolicyStoreConfig_basic(mode string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = %[1]q
+  }
+}
+`, mode)
+}
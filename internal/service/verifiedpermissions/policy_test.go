@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVerifiedPermissionsPolicy_static(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy verifiedpermissions.GetPolicyOutput
+	resourceName := "aws_verifiedpermissions_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissions)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissions),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_static("permit (principal, action == Action::\"view\", resource in Album:: \"test_album\");", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_store_id", "aws_verifiedpermissions_policy_store.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_id"),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "STATIC"),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.0.description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPolicyConfig_static("permit (principal, action == Action::\"edit\", resource in Album:: \"test_album\");", "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.0.description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicy_templateLinked(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy verifiedpermissions.GetPolicyOutput
+	resourceName := "aws_verifiedpermissions_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissions)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissions),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_templateLinked("alice"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "TEMPLATE_LINKED"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "definition.0.template_linked.0.policy_template_id", "aws_verifiedpermissions_policy_template.test", "policy_template_id"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.principal.0.entity_id", "alice"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPolicyConfig_templateLinked("bob"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.principal.0.entity_id", "bob"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy verifiedpermissions.GetPolicyOutput
+	resourceName := "aws_verifiedpermissions_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissions)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissions),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_static("permit (principal, action == Action::\"view\", resource in Album:: \"test_album\");", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfverifiedpermissions.ResourcePolicy, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_verifiedpermissions_policy" {
+				continue
+			}
+
+			_, err := tfverifiedpermissions.FindPolicyByTwoPartKey(ctx, conn, rs.Primary.Attributes["policy_store_id"], rs.Primary.Attributes["policy_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Verified Permissions Policy %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPolicyExists(ctx context.Context, n string, v *verifiedpermissions.GetPolicyOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		output, err := tfverifiedpermissions.FindPolicyByTwoPartKey(ctx, conn, rs.Primary.Attributes["policy_store_id"], rs.Primary.Attributes["policy_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPolicyConfig_static(statement, description string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = "OFF"
+  }
+}
+
+resource "aws_verifiedpermissions_policy" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  definition {
+    static {
+      description = %[2]q
+      statement   = %[1]q
+    }
+  }
+}
+`, statement, description)
+}
+
+func testAccPolicyConfig_templateLinked(principal string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = "OFF"
+  }
+}
+
+resource "aws_verifiedpermissions_policy_template" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+  statement       = "permit (principal == ?principal, action in PhotoFlash::Action::\"FullPhotoAccess\", resource == ?resource);"
+}
+
+resource "aws_verifiedpermissions_policy" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  definition {
+    template_linked {
+      policy_template_id = aws_verifiedpermissions_policy_template.test.policy_template_id
+
+      principal {
+        entity_id   = %[1]q
+        entity_type = "PhotoFlash::User"
+      }
+
+      resource {
+        entity_id   = "test_album"
+        entity_type = "PhotoFlash::Album"
+      }
+    }
+  }
+}
+`, principal)
+}
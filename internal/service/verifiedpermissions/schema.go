@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Schema")
+func newResourceSchema(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceSchema{}, nil
+}
+
+type resourceSchema struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceSchema) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_schema"
+}
+
+func (r *resourceSchema) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"namespaces": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							CustomType: fwtypes.JSONType{},
+							Required:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSchema) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceSchemaData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	policyStoreID := data.PolicyStoreID.ValueString()
+	output, err := putSchema(ctx, conn, policyStoreID, data.definitionValue(ctx))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Verified Permissions Schema (%s)", policyStoreID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(policyStoreID)
+	data.Namespaces = flex.FlattenFrameworkStringValueSet(ctx, output.Namespaces)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSchema) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceSchemaData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	output, err := findSchemaByPolicyStoreID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Permissions Schema (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.PolicyStoreID = flex.StringToFramework(ctx, output.PolicyStoreId)
+	data.Definition = flattenSchemaDefinition(ctx, output.Schema)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSchema) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceSchemaData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	if !new.Definition.Equal(old.Definition) {
+		output, err := putSchema(ctx, conn, new.ID.ValueString(), new.definitionValue(ctx))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Verified Permissions Schema (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.Namespaces = flex.FlattenFrameworkStringValueSet(ctx, output.Namespaces)
+	} else {
+		new.Namespaces = old.Namespaces
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceSchema) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceSchemaData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	// There is no API to delete a policy store's schema. Replace it with an empty schema.
+	_, err := putSchema(ctx, conn, data.ID.ValueString(), emptySchemaDefinition)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Verified Permissions Schema (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceSchema) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("policy_store_id"), request.ID)...)
+}
+
+const (
+	emptySchemaDefinition = "{}"
+)
+
+type resourceSchemaData struct {
+	Definition    types.List   `tfsdk:"definition"`
+	ID            types.String `tfsdk:"id"`
+	Namespaces    types.Set    `tfsdk:"namespaces"`
+	PolicyStoreID types.String `tfsdk:"policy_store_id"`
+}
+
+type schemaDefinitionData struct {
+	Value fwtypes.JSONValue `tfsdk:"value"`
+}
+
+func (data *resourceSchemaData) definitionValue(ctx context.Context) string {
+	definition := flex.ExpandFrameworkListNestedBlock(ctx, data.Definition, func(ctx context.Context, v schemaDefinitionData) string {
+		return v.Value.ValueString()
+	})
+
+	if len(definition) == 0 {
+		return emptySchemaDefinition
+	}
+
+	return definition[0]
+}
+
+func flattenSchemaDefinition(ctx context.Context, apiObject *string) types.List {
+	return flex.FlattenFrameworkListNestedBlock(ctx, []string{aws.ToString(apiObject)}, func(ctx context.Context, v string) schemaDefinitionData {
+		return schemaDefinitionData{
+			Value: fwtypes.NewJSONValue(v),
+		}
+	})
+}
+
+func putSchema(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, definition string) (*verifiedpermissions.PutSchemaOutput, error) {
+	input := &verifiedpermissions.PutSchemaInput{
+		Definition: &awstypes.SchemaDefinitionMemberCedarJson{
+			Value: definition,
+		},
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	return conn.PutSchema(ctx, input)
+}
+
+func findSchemaByPolicyStoreID(ctx context.Context, conn *verifiedpermissions.Client, id string) (*verifiedpermissions.GetSchemaOutput, error) {
+	input := &verifiedpermissions.GetSchemaInput{
+		PolicyStoreId: aws.String(id),
+	}
+
+	output, err := conn.GetSchema(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Schema == nil || aws.ToString(output.Schema) == emptySchemaDefinition {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -52,6 +52,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dlm"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dms"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/docdb"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/docdbelastic"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ds"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
@@ -85,6 +86,8 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/internetmonitor"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
@@ -99,6 +102,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/location"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfresource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	defaultStatusHistorySize      = 5
+	defaultStatusProgressInterval = 1 * time.Minute
+)
+
+// StatusTransition records a change in the status returned by a waiter's refresh function.
+type StatusTransition struct {
+	Time   time.Time
+	Status string
+	Reason string
+}
+
+func (t StatusTransition) String() string {
+	s := fmt.Sprintf("%s: %q", t.Time.UTC().Format(time.RFC3339), t.Status)
+
+	if t.Reason != "" {
+		s += fmt.Sprintf(" (%s)", t.Reason)
+	}
+
+	return s
+}
+
+// StatusReasonFunc returns a human-readable reason for the current status, e.g. a StatusReason field, from a refresh function's result.
+type StatusReasonFunc func(output interface{}) string
+
+type StatusWaitOptions struct {
+	HistorySize      int              // Number of most recent status transitions to include in errors
+	ProgressInterval time.Duration    // Log progress no more often than this
+	ReasonFunc       StatusReasonFunc // Extracts the status reason from a refresh function's result
+}
+
+type StatusWaitOptionsFunc func(*StatusWaitOptions)
+
+func WithStatusHistorySize(historySize int) StatusWaitOptionsFunc {
+	return func(o *StatusWaitOptions) {
+		o.HistorySize = historySize
+	}
+}
+
+func WithStatusProgressInterval(progressInterval time.Duration) StatusWaitOptionsFunc {
+	return func(o *StatusWaitOptions) {
+		o.ProgressInterval = progressInterval
+	}
+}
+
+func WithStatusReasonFunc(f StatusReasonFunc) StatusWaitOptionsFunc {
+	return func(o *StatusWaitOptions) {
+		o.ReasonFunc = f
+	}
+}
+
+// StatusWaitError is returned by WaitForStatus when the wait does not reach the target status.
+// It wraps the underlying retry.TimeoutError, retry.UnexpectedStateError or refresh error and
+// adds the most recent status transitions and the last error returned by the refresh function.
+type StatusWaitError struct {
+	Err         error
+	History     []StatusTransition
+	LastError   error
+	historySize int
+}
+
+func (e *StatusWaitError) Error() string {
+	var sb strings.Builder
+
+	sb.WriteString(e.Err.Error())
+
+	if history := e.History; len(history) > 0 {
+		if n := e.historySize; n > 0 && len(history) > n {
+			history = history[len(history)-n:]
+		}
+
+		transitions := make([]string, len(history))
+		for i, v := range history {
+			transitions[i] = v.String()
+		}
+
+		fmt.Fprintf(&sb, "; status history: [%s]", strings.Join(transitions, ", "))
+	}
+
+	// Don't repeat the last error if the wrapped error already includes it.
+	if lastErr := e.LastError; lastErr != nil && !strings.Contains(e.Err.Error(), lastErr.Error()) {
+		fmt.Fprintf(&sb, "; last error: %s", lastErr)
+	}
+
+	return sb.String()
+}
+
+func (e *StatusWaitError) Unwrap() error {
+	return e.Err
+}
+
+// statusHistory is a concurrency-safe record of status transitions.
+type statusHistory struct {
+	mu          sync.Mutex
+	transitions []StatusTransition
+	lastErr     error
+}
+
+func (h *statusHistory) record(status, reason string, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if err != nil {
+		h.lastErr = err
+	}
+
+	if n := len(h.transitions); n > 0 && h.transitions[n-1].Status == status && h.transitions[n-1].Reason == reason {
+		return
+	}
+
+	h.transitions = append(h.transitions, StatusTransition{
+		Time:   time.Now(),
+		Status: status,
+		Reason: reason,
+	})
+}
+
+func (h *statusHistory) snapshot() ([]StatusTransition, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	transitions := make([]StatusTransition, len(h.transitions))
+	copy(transitions, h.transitions)
+
+	return transitions, h.lastErr
+}
+
+// WaitForStatus waits using the specified StateChangeConf, recording the history of statuses returned
+// by its refresh function and periodically logging progress (elapsed time, last status and reason).
+// If the target status is not reached, the returned error is a *StatusWaitError which includes the most recent
+// status transitions and the last error returned by the refresh function.
+// The error wraps the underlying StateChangeConf error, which can be retrieved using errors.As.
+// It can be used by both Plugin SDK and Plugin Framework resources.
+func WaitForStatus(ctx context.Context, conf *retry.StateChangeConf, optFns ...StatusWaitOptionsFunc) (interface{}, error) {
+	options := StatusWaitOptions{
+		HistorySize:      defaultStatusHistorySize,
+		ProgressInterval: defaultStatusProgressInterval,
+	}
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	var history statusHistory
+	start := time.Now()
+	lastLogged := start
+	attempts := 0
+	refresh := conf.Refresh
+
+	// Copy so that the caller's StateChangeConf is not modified.
+	c := *conf
+	c.Refresh = func() (interface{}, string, error) {
+		output, status, err := refresh()
+		attempts++
+
+		var reason string
+		if options.ReasonFunc != nil && output != nil {
+			reason = options.ReasonFunc(output)
+		}
+
+		history.record(status, reason, err)
+
+		if now := time.Now(); now.Sub(lastLogged) >= options.ProgressInterval {
+			lastLogged = now
+			tflog.Info(ctx, "Waiting for status", map[string]any{
+				"elapsed":  now.Sub(start).Round(time.Second).String(),
+				"status":   status,
+				"reason":   reason,
+				"attempts": attempts,
+				"target":   c.Target,
+			})
+		}
+
+		return output, status, err
+	}
+
+	output, err := c.WaitForStateContext(ctx)
+
+	if err == nil {
+		return output, nil
+	}
+
+	transitions, lastErr := history.snapshot()
+	SetLastError(err, lastErr)
+
+	return output, &StatusWaitError{
+		Err:         err,
+		History:     transitions,
+		LastError:   lastErr,
+		historySize: options.HistorySize,
+	}
+}
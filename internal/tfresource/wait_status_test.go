@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfresource_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testStatusOutput struct {
+	status string
+	reason string
+}
+
+func TestWaitForStatus(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		Statuses      []testStatusOutput
+		Err           error
+		Timeout       time.Duration
+		ExpectError   bool
+		ExpectTimeout bool
+		ExpectInError []string
+	}{
+		{
+			Name: "reaches target",
+			Statuses: []testStatusOutput{
+				{status: "CREATING"},
+				{status: "CREATING"},
+				{status: "ACTIVE"},
+			},
+			Timeout: 5 * time.Second,
+		},
+		{
+			Name: "timeout",
+			Statuses: []testStatusOutput{
+				{status: "CREATING", reason: "allocating capacity"},
+				{status: "UPDATING", reason: "applying configuration"},
+			},
+			Timeout:       2 * time.Second,
+			ExpectError:   true,
+			ExpectTimeout: true,
+			ExpectInError: []string{
+				"timeout while waiting for state to become 'ACTIVE'",
+				`"CREATING" (allocating capacity)`,
+				`"UPDATING" (applying configuration)`,
+			},
+		},
+		{
+			Name: "unexpected state",
+			Statuses: []testStatusOutput{
+				{status: "CREATING"},
+				{status: "FAILED", reason: "insufficient capacity"},
+			},
+			Timeout:     5 * time.Second,
+			ExpectError: true,
+			ExpectInError: []string{
+				"unexpected state 'FAILED'",
+				`"CREATING"`,
+				`"FAILED" (insufficient capacity)`,
+			},
+		},
+		{
+			Name: "refresh error",
+			Statuses: []testStatusOutput{
+				{status: "CREATING"},
+			},
+			Err:         errors.New("AccessDenied"),
+			Timeout:     5 * time.Second,
+			ExpectError: true,
+			ExpectInError: []string{
+				"AccessDenied",
+				`"CREATING"`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+			i := 0
+
+			conf := &retry.StateChangeConf{
+				Pending: []string{"CREATING", "UPDATING"},
+				Target:  []string{"ACTIVE"},
+				Refresh: func() (interface{}, string, error) {
+					if i >= len(testCase.Statuses) {
+						if testCase.Err != nil {
+							return nil, "", testCase.Err
+						}
+
+						i = len(testCase.Statuses) - 1
+					}
+
+					output := &testCase.Statuses[i]
+					i++
+
+					return output, output.status, nil
+				},
+				Timeout:      testCase.Timeout,
+				PollInterval: 10 * time.Millisecond,
+			}
+
+			_, err := tfresource.WaitForStatus(ctx, conf,
+				tfresource.WithStatusProgressInterval(10*time.Millisecond),
+				tfresource.WithStatusReasonFunc(func(output interface{}) string {
+					return output.(*testStatusOutput).reason
+				}),
+			)
+
+			if !testCase.ExpectError {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			var statusErr *tfresource.StatusWaitError
+			if !errors.As(err, &statusErr) {
+				t.Fatalf("expected StatusWaitError, got %T", err)
+			}
+
+			var timeoutErr *retry.TimeoutError
+			if got, want := errors.As(err, &timeoutErr), testCase.ExpectTimeout; got != want {
+				t.Errorf("errors.As(err, *retry.TimeoutError) = %t, want %t", got, want)
+			}
+
+			for _, want := range testCase.ExpectInError {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not contain %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestStatusWaitErrorHistorySize(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	statuses := []string{"PENDING_1", "PENDING_2", "PENDING_3", "PENDING_4"}
+	i := 0
+
+	conf := &retry.StateChangeConf{
+		Pending: statuses,
+		Target:  []string{"ACTIVE"},
+		Refresh: func() (interface{}, string, error) {
+			status := statuses[i%len(statuses)]
+			i++
+
+			return status, status, nil
+		},
+		Timeout:      1 * time.Second,
+		PollInterval: 10 * time.Millisecond,
+	}
+
+	_, err := tfresource.WaitForStatus(ctx, conf, tfresource.WithStatusHistorySize(2))
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	var statusErr *tfresource.StatusWaitError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("expected StatusWaitError, got %T", err)
+	}
+
+	if len(statusErr.History) <= 2 {
+		t.Errorf("expected full history to be recorded, got %d transitions", len(statusErr.History))
+	}
+
+	if got, want := strings.Count(err.Error(), `"PENDING_`), 2; got != want {
+		t.Errorf("status transitions in error = %d, want %d: %s", got, want, err)
+	}
+}
@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package awssdk reads the API model (operations, shapes and enums) of an
+// AWS SDK for Go v2 service package from its generated Go source.
+package awssdk
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// TypesPackage is the name of the SDK service package's sub-package containing shapes and enums.
+	TypesPackage = "types"
+
+	requiredMemberComment   = "This member is required."
+	serviceImportPathPrefix = "github.com/aws/aws-sdk-go-v2/service/"
+)
+
+// Package is the API model of an AWS SDK for Go v2 service package.
+type Package struct {
+	// Name is the Go package name, e.g. "docdbelastic".
+	Name string
+	// ImportPath is the Go import path, e.g. "github.com/aws/aws-sdk-go-v2/service/docdbelastic".
+	ImportPath string
+	// Operations contains the names of the API operations implemented by the package's Client.
+	Operations map[string]bool
+	// Structs contains operation input/output structures keyed by name and shapes keyed by "types.<Name>".
+	Structs map[string]*Struct
+	// Enums contains enumerations keyed by "types.<Name>".
+	Enums map[string]*Enum
+	// Unions contains the names of union (interface) shapes, e.g. "types.Action".
+	Unions map[string]bool
+	// Errors contains the names of modeled errors, e.g. "types.ResourceNotFoundException".
+	Errors map[string]bool
+}
+
+// Struct is an operation input or output structure or a shape.
+type Struct struct {
+	Name   string
+	Fields []*Field
+}
+
+// Field is an exported member of a Struct.
+type Field struct {
+	// Name is the Go field name.
+	Name string
+	// Type is the Go type of the field as seen from the service package, e.g. "*string", "[]types.Tag" or "*time.Time".
+	Type string
+	// Required is true if the member is documented as required.
+	Required bool
+}
+
+// Enum is a string enumeration.
+type Enum struct {
+	Name   string
+	Values []EnumValue
+}
+
+// EnumValue is a single enumeration value and the name of its Go constant.
+type EnumValue struct {
+	Const string
+	Value string
+}
+
+// Field returns the named field or nil.
+func (s *Struct) Field(name string) *Field {
+	if s == nil {
+		return nil
+	}
+
+	for _, f := range s.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+
+	return nil
+}
+
+// ServiceImportPath returns the import path of the named AWS SDK for Go v2 service package, e.g. "docdbelastic".
+func ServiceImportPath(name string) string {
+	return serviceImportPathPrefix + name
+}
+
+// Dir returns the source directory of the AWS SDK for Go v2 package with the specified import path,
+// as resolved by the Go module containing the working directory.
+func Dir(importPath string) (string, error) {
+	output, err := exec.Command("go", "list", "-f", "{{.Dir}}", importPath).Output()
+
+	if err != nil {
+		if err, ok := err.(*exec.ExitError); ok {
+			return "", fmt.Errorf("locating Go package (%s): %s", importPath, strings.TrimSpace(string(err.Stderr)))
+		}
+
+		return "", fmt.Errorf("locating Go package (%s): %w", importPath, err)
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+// Load reads the API model from the AWS SDK for Go v2 service package source in dir.
+func Load(dir, importPath string) (*Package, error) {
+	pkg := &Package{
+		ImportPath: importPath,
+		Operations: make(map[string]bool),
+		Structs:    make(map[string]*Struct),
+		Enums:      make(map[string]*Enum),
+		Unions:     make(map[string]bool),
+		Errors:     make(map[string]bool),
+	}
+
+	name, err := pkg.loadDir(dir, "")
+
+	if err != nil {
+		return nil, err
+	}
+
+	pkg.Name = name
+
+	if _, err := pkg.loadDir(filepath.Join(dir, TypesPackage), TypesPackage); err != nil {
+		return nil, err
+	}
+
+	return pkg, nil
+}
+
+func (pkg *Package) loadDir(dir, qualifier string) (string, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+
+	if err != nil {
+		return "", fmt.Errorf("parsing Go source (%s): %w", dir, err)
+	}
+
+	if len(pkgs) != 1 {
+		return "", fmt.Errorf("parsing Go source (%s): expected 1 package, found %d", dir, len(pkgs))
+	}
+
+	var name string
+	var files []*ast.File
+	for n, p := range pkgs {
+		name = n
+		for _, f := range p.Files {
+			files = append(files, f)
+		}
+	}
+
+	// Declared type names are needed to qualify references within the types package.
+	declared := make(map[string]bool)
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			if decl, ok := decl.(*ast.GenDecl); ok && decl.Tok == token.TYPE {
+				for _, spec := range decl.Specs {
+					declared[spec.(*ast.TypeSpec).Name.Name] = true
+				}
+			}
+		}
+	}
+
+	qualify := func(name string) string {
+		if qualifier == "" {
+			return name
+		}
+
+		return qualifier + "." + name
+	}
+
+	typeString := func(expr ast.Expr) string {
+		return exprString(expr, func(name string) string {
+			if declared[name] {
+				return qualify(name)
+			}
+
+			return name
+		})
+	}
+
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if qualifier == "" && isClientMethod(decl) && decl.Name.IsExported() {
+					pkg.Operations[decl.Name.Name] = true
+				}
+
+				// Modeled errors implement ErrorCode().
+				if qualifier != "" && decl.Recv != nil && decl.Name.Name == "ErrorCode" {
+					if star, ok := decl.Recv.List[0].Type.(*ast.StarExpr); ok {
+						if ident, ok := star.X.(*ast.Ident); ok {
+							pkg.Errors[qualify(ident.Name)] = true
+						}
+					}
+				}
+
+			case *ast.GenDecl:
+				switch decl.Tok {
+				case token.TYPE:
+					for _, spec := range decl.Specs {
+						spec := spec.(*ast.TypeSpec)
+
+						if !spec.Name.IsExported() {
+							continue
+						}
+
+						switch t := spec.Type.(type) {
+						case *ast.StructType:
+							s := &Struct{Name: qualify(spec.Name.Name)}
+
+							for _, field := range t.Fields.List {
+								for _, n := range field.Names {
+									if !n.IsExported() || n.Name == "ResultMetadata" {
+										continue
+									}
+
+									s.Fields = append(s.Fields, &Field{
+										Name:     n.Name,
+										Type:     typeString(field.Type),
+										Required: field.Doc != nil && strings.Contains(field.Doc.Text(), requiredMemberComment),
+									})
+								}
+							}
+
+							pkg.Structs[s.Name] = s
+
+						case *ast.InterfaceType:
+							pkg.Unions[qualify(spec.Name.Name)] = true
+
+						case *ast.Ident:
+							if qualifier != "" && t.Name == "string" {
+								if _, ok := pkg.Enums[qualify(spec.Name.Name)]; !ok {
+									pkg.Enums[qualify(spec.Name.Name)] = &Enum{Name: qualify(spec.Name.Name)}
+								}
+							}
+						}
+					}
+
+				case token.CONST:
+					if qualifier == "" {
+						continue
+					}
+
+					for _, spec := range decl.Specs {
+						spec := spec.(*ast.ValueSpec)
+
+						ident, ok := spec.Type.(*ast.Ident)
+						if !ok || len(spec.Values) != len(spec.Names) {
+							continue
+						}
+
+						enum, ok := pkg.Enums[qualify(ident.Name)]
+						if !ok {
+							enum = &Enum{Name: qualify(ident.Name)}
+							pkg.Enums[enum.Name] = enum
+						}
+
+						for i, n := range spec.Names {
+							lit, ok := spec.Values[i].(*ast.BasicLit)
+							if !ok || lit.Kind != token.STRING {
+								continue
+							}
+
+							v, err := strconv.Unquote(lit.Value)
+							if err != nil {
+								continue
+							}
+
+							enum.Values = append(enum.Values, EnumValue{
+								Const: qualify(n.Name),
+								Value: v,
+							})
+						}
+					}
+				}
+			}
+		}
+	}
+
+	for _, s := range pkg.Structs {
+		sort.SliceStable(s.Fields, func(i, j int) bool {
+			return s.Fields[i].Name < s.Fields[j].Name
+		})
+	}
+
+	return name, nil
+}
+
+// isClientMethod returns whether the function is an API operation method of the form
+// func (c *Client) Op(ctx context.Context, params *OpInput, optFns ...func(*Options)) (*OpOutput, error).
+func isClientMethod(decl *ast.FuncDecl) bool {
+	if decl.Recv == nil || len(decl.Recv.List) != 1 {
+		return false
+	}
+
+	star, ok := decl.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+
+	if ident, ok := star.X.(*ast.Ident); !ok || ident.Name != "Client" {
+		return false
+	}
+
+	params := decl.Type.Params.List
+	if len(params) != 3 {
+		return false
+	}
+
+	star, ok = params[1].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+
+	ident, ok := star.X.(*ast.Ident)
+
+	return ok && ident.Name == decl.Name.Name+"Input"
+}
+
+func exprString(expr ast.Expr, ident func(string) string) string {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		return ident(expr.Name)
+	case *ast.StarExpr:
+		return "*" + exprString(expr.X, ident)
+	case *ast.ArrayType:
+		return "[]" + exprString(expr.Elt, ident)
+	case *ast.MapType:
+		return "map[" + exprString(expr.Key, ident) + "]" + exprString(expr.Value, ident)
+	case *ast.SelectorExpr:
+		return exprString(expr.X, func(name string) string { return name }) + "." + expr.Sel.Name
+	case *ast.InterfaceType:
+		return "interface{}"
+	}
+
+	return fmt.Sprintf("%T", expr)
+}
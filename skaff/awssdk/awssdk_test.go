@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package awssdk
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	pkg, err := Load("testdata/widgets", "example.com/widgets")
+	if err != nil {
+		t.Fatalf("loading package: %s", err)
+	}
+
+	if got, expected := pkg.Name, "widgets"; got != expected {
+		t.Errorf("got name %s, expected %s", got, expected)
+	}
+
+	if got, expected := pkg.Operations, map[string]bool{"CreateWidget": true, "DeleteWidget": true, "GetWidget": true, "UpdateWidget": true}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got operations %v, expected %v", got, expected)
+	}
+
+	if got, expected := pkg.Errors, map[string]bool{"types.ResourceNotFoundException": true}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got errors %v, expected %v", got, expected)
+	}
+
+	testCases := []struct {
+		TestName string
+		Struct   string
+		Field    string
+		Expected *Field
+	}{
+		{
+			TestName: "required input field",
+			Struct:   "CreateWidgetInput",
+			Field:    "WidgetName",
+			Expected: &Field{Name: "WidgetName", Type: "*string", Required: true},
+		},
+		{
+			TestName: "optional shape input field",
+			Struct:   "CreateWidgetInput",
+			Field:    "Configuration",
+			Expected: &Field{Name: "Configuration", Type: "*types.Configuration"},
+		},
+		{
+			TestName: "map input field",
+			Struct:   "CreateWidgetInput",
+			Field:    "Tags",
+			Expected: &Field{Name: "Tags", Type: "map[string]string"},
+		},
+		{
+			TestName: "result metadata",
+			Struct:   "CreateWidgetOutput",
+			Field:    "ResultMetadata",
+		},
+		{
+			TestName: "shape field referencing shape",
+			Struct:   "types.Configuration",
+			Field:    "Parent",
+			Expected: &Field{Name: "Parent", Type: "*types.Node"},
+		},
+		{
+			TestName: "shape field referencing enum",
+			Struct:   "types.Widget",
+			Field:    "Status",
+			Expected: &Field{Name: "Status", Type: "types.Status", Required: true},
+		},
+		{
+			TestName: "unexported field",
+			Struct:   "types.Widget",
+			Field:    "noSmithyDocumentSerde",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			s, ok := pkg.Structs[testCase.Struct]
+			if !ok {
+				t.Fatalf("struct %s not found", testCase.Struct)
+			}
+
+			if got := s.Field(testCase.Field); !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got field %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+
+	expectedEnum := &Enum{
+		Name: "types.WidgetType",
+		Values: []EnumValue{
+			{Const: "types.WidgetTypeStandard", Value: "STANDARD"},
+			{Const: "types.WidgetTypePremium", Value: "PREMIUM"},
+		},
+	}
+	if got := pkg.Enums["types.WidgetType"]; !reflect.DeepEqual(got, expectedEnum) {
+		t.Errorf("got enum %#v, expected %#v", got, expectedEnum)
+	}
+}
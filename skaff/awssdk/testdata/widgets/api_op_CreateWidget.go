@@ -0,0 +1,57 @@
+// Code generated by smithy-go-codegen DO NOT EDIT.
+
+package widgets
+
+import (
+	"context"
+
+	"example.com/widgets/types"
+)
+
+// Creates a widget.
+func (c *Client) CreateWidget(ctx context.Context, params *CreateWidgetInput, optFns ...func(*Options)) (*CreateWidgetOutput, error) {
+	return nil, nil
+}
+
+type CreateWidgetInput struct {
+
+	// The name of the widget.
+	//
+	// This member is required.
+	WidgetName *string
+
+	// The size of the widget.
+	//
+	// This member is required.
+	Size *int32
+
+	// A unique, case-sensitive identifier.
+	ClientToken *string
+
+	// The widget's configuration.
+	Configuration *types.Configuration
+
+	// The KMS key used to encrypt the widget.
+	KmsKeyId *string
+
+	// The widget's type.
+	Type types.WidgetType
+
+	// The widget's tags.
+	Tags map[string]string
+
+	noSmithyDocumentSerde
+}
+
+type CreateWidgetOutput struct {
+
+	// The widget.
+	//
+	// This member is required.
+	Widget *types.Widget
+
+	// Metadata pertaining to the operation's result.
+	ResultMetadata middleware.Metadata
+
+	noSmithyDocumentSerde
+}
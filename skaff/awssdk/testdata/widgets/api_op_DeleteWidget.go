@@ -0,0 +1,30 @@
+// Code generated by smithy-go-codegen DO NOT EDIT.
+
+package widgets
+
+import (
+	"context"
+)
+
+// Deletes a widget.
+func (c *Client) DeleteWidget(ctx context.Context, params *DeleteWidgetInput, optFns ...func(*Options)) (*DeleteWidgetOutput, error) {
+	return nil, nil
+}
+
+type DeleteWidgetInput struct {
+
+	// The ARN of the widget.
+	//
+	// This member is required.
+	WidgetArn *string
+
+	noSmithyDocumentSerde
+}
+
+type DeleteWidgetOutput struct {
+
+	// Metadata pertaining to the operation's result.
+	ResultMetadata middleware.Metadata
+
+	noSmithyDocumentSerde
+}
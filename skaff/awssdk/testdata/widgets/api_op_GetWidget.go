@@ -0,0 +1,37 @@
+// Code generated by smithy-go-codegen DO NOT EDIT.
+
+package widgets
+
+import (
+	"context"
+
+	"example.com/widgets/types"
+)
+
+// Returns information about a widget.
+func (c *Client) GetWidget(ctx context.Context, params *GetWidgetInput, optFns ...func(*Options)) (*GetWidgetOutput, error) {
+	return nil, nil
+}
+
+type GetWidgetInput struct {
+
+	// The ARN of the widget.
+	//
+	// This member is required.
+	WidgetArn *string
+
+	noSmithyDocumentSerde
+}
+
+type GetWidgetOutput struct {
+
+	// The widget.
+	//
+	// This member is required.
+	Widget *types.Widget
+
+	// Metadata pertaining to the operation's result.
+	ResultMetadata middleware.Metadata
+
+	noSmithyDocumentSerde
+}
@@ -0,0 +1,33 @@
+// Code generated by smithy-go-codegen DO NOT EDIT.
+
+package widgets
+
+import (
+	"context"
+)
+
+// Modifies a widget.
+func (c *Client) UpdateWidget(ctx context.Context, params *UpdateWidgetInput, optFns ...func(*Options)) (*UpdateWidgetOutput, error) {
+	return nil, nil
+}
+
+type UpdateWidgetInput struct {
+
+	// The ARN of the widget.
+	//
+	// This member is required.
+	WidgetArn *string
+
+	// The size of the widget.
+	Size *int32
+
+	noSmithyDocumentSerde
+}
+
+type UpdateWidgetOutput struct {
+
+	// Metadata pertaining to the operation's result.
+	ResultMetadata middleware.Metadata
+
+	noSmithyDocumentSerde
+}
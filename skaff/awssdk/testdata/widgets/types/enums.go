@@ -0,0 +1,22 @@
+// Code generated by smithy-go-codegen DO NOT EDIT.
+
+package types
+
+type Status string
+
+// Enum values for Status
+const (
+	StatusActive   Status = "ACTIVE"
+	StatusCreating Status = "CREATING"
+	StatusUpdating Status = "UPDATING"
+	StatusDeleting Status = "DELETING"
+	StatusFailed   Status = "FAILED"
+)
+
+type WidgetType string
+
+// Enum values for WidgetType
+const (
+	WidgetTypeStandard WidgetType = "STANDARD"
+	WidgetTypePremium  WidgetType = "PREMIUM"
+)
@@ -0,0 +1,17 @@
+// Code generated by smithy-go-codegen DO NOT EDIT.
+
+package types
+
+// The specified resource could not be located.
+type ResourceNotFoundException struct {
+	Message *string
+
+	noSmithyDocumentSerde
+}
+
+func (e *ResourceNotFoundException) Error() string {
+	return ""
+}
+func (e *ResourceNotFoundException) ErrorCode() string {
+	return "ResourceNotFoundException"
+}
@@ -0,0 +1,83 @@
+// Code generated by smithy-go-codegen DO NOT EDIT.
+
+package types
+
+// A widget's configuration.
+type Configuration struct {
+
+	// Whether the widget is enabled.
+	//
+	// This member is required.
+	Enabled *bool
+
+	// The widget's labels.
+	Labels []string
+
+	// A recursive shape.
+	Parent *Node
+
+	noSmithyDocumentSerde
+}
+
+// A recursive shape.
+type Node struct {
+
+	// The child node.
+	Child *Node
+
+	noSmithyDocumentSerde
+}
+
+// A widget.
+type Widget struct {
+
+	// The widget's configuration.
+	Configuration *Configuration
+
+	// The time the widget was created.
+	//
+	// This member is required.
+	CreateTime *string
+
+	// The KMS key used to encrypt the widget.
+	KmsKeyId *string
+
+	// The size of the widget.
+	//
+	// This member is required.
+	Size *int32
+
+	// The widget's status.
+	//
+	// This member is required.
+	Status Status
+
+	// The widget's type.
+	Type WidgetType
+
+	// The ARN of the widget.
+	//
+	// This member is required.
+	WidgetArn *string
+
+	// The name of the widget.
+	//
+	// This member is required.
+	WidgetName *string
+
+	// The widget's dimensions.
+	Dimensions *Dimensions
+
+	noSmithyDocumentSerde
+}
+
+// A widget's dimensions.
+type Dimensions struct {
+
+	// The width.
+	Width *float64
+
+	noSmithyDocumentSerde
+}
+
+type noSmithyDocumentSerde = document.NoSerde
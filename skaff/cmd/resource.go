@@ -16,13 +16,14 @@ var (
 	v1              bool
 	pluginFramework bool
 	includeTags     bool
+	fromSDK         bool
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return resource.Create(name, snakeName, !clearComments, force, !v1, pluginFramework, includeTags)
+		return resource.Create(name, snakeName, !clearComments, force, !v1, pluginFramework, includeTags, fromSDK)
 	},
 }
 
@@ -35,4 +36,5 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&v1, "v1", "o", false, "generate for AWS Go SDK v1 (some existing services)")
 	resourceCmd.Flags().BoolVarP(&pluginFramework, "plugin-framework", "p", false, "generate for Terraform Plugin-Framework")
 	resourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	resourceCmd.Flags().BoolVarP(&fromSDK, "from-sdk", "a", false, "generate schema, CRUD, finder and waiters for Terraform Plugin-Framework from the AWS Go SDK v2 API model")
 }
@@ -8,6 +8,7 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
+	"go/format"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -16,6 +17,7 @@ import (
 	"text/template"
 
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/awssdk"
 )
 
 //go:embed resource.tmpl
@@ -24,12 +26,22 @@ var resourceTmpl string
 //go:embed resourcefw.tmpl
 var resourceFrameworkTmpl string
 
+//go:embed resourcefwsdk.tmpl
+var resourceFrameworkSDKTmpl string
+
 //go:embed resourcetest.tmpl
 var resourceTestTmpl string
 
+//go:embed resourcetestsdk.tmpl
+var resourceTestSDKTmpl string
+
 //go:embed websitedoc.tmpl
 var websiteTmpl string
 
+var templateFuncs = template.FuncMap{
+	"join": strings.Join,
+}
+
 type TemplateData struct {
 	Resource             string
 	ResourceLower        string
@@ -45,6 +57,8 @@ type TemplateData struct {
 	PluginFramework      bool
 	HumanResourceName    string
 	ProviderResourceName string
+	// SDK is the resource's AWS SDK for Go v2 API model, if generating from the SDK.
+	SDK *SDKResource
 }
 
 func ToSnakeCase(upper string, snakeName string) string {
@@ -71,7 +85,7 @@ func ProviderResourceName(servicePackage, snakeName string) string {
 	return fmt.Sprintf("aws_%s_%s", servicePackage, snakeName)
 }
 
-func Create(resName, snakeName string, comments, force, v2, pluginFramework, tags bool) error {
+func Create(resName, snakeName string, comments, force, v2, pluginFramework, tags, fromSDK bool) error {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
 		return fmt.Errorf("error reading working directory: %s", err)
@@ -91,6 +105,10 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework, tag
 		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
 	}
 
+	if fromSDK && !v2 {
+		return fmt.Errorf("error checking: generating from the AWS SDK API model requires AWS Go SDK v2")
+	}
+
 	snakeName = ToSnakeCase(resName, snakeName)
 
 	s, err := names.ProviderNameUpper(servicePackage)
@@ -126,16 +144,31 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework, tag
 	}
 
 	tmpl := resourceTmpl
+	testTmpl := resourceTestTmpl
 	if pluginFramework {
 		tmpl = resourceFrameworkTmpl
 	}
+
+	if fromSDK {
+		r, err := loadSDKResource(servicePackage, resName)
+		if err != nil {
+			return err
+		}
+
+		templateData.SDK = r
+		templateData.PluginFramework = true
+		templateData.IncludeTags = r.Tags
+		tmpl = resourceFrameworkSDKTmpl
+		testTmpl = resourceTestSDKTmpl
+	}
+
 	f := fmt.Sprintf("%s.go", snakeName)
 	if err = writeTemplate("newres", f, tmpl, force, templateData); err != nil {
 		return fmt.Errorf("writing resource template: %w", err)
 	}
 
 	tf := fmt.Sprintf("%s_test.go", snakeName)
-	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
+	if err = writeTemplate("restest", tf, testTmpl, force, templateData); err != nil {
 		return fmt.Errorf("writing resource test template: %w", err)
 	}
 
@@ -148,6 +181,33 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework, tag
 	return nil
 }
 
+// loadSDKResource builds the named resource's API model from the service's AWS SDK for Go v2 package.
+func loadSDKResource(servicePackage, resName string) (*SDKResource, error) {
+	sdkPackage, err := names.AWSGoV2Package(servicePackage)
+	if err != nil {
+		return nil, fmt.Errorf("error getting AWS Go SDK v2 package: %w", err)
+	}
+
+	importPath := awssdk.ServiceImportPath(sdkPackage)
+
+	dir, err := awssdk.Dir(importPath)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, err := awssdk.Load(dir, importPath)
+	if err != nil {
+		return nil, fmt.Errorf("error loading AWS Go SDK v2 API model: %w", err)
+	}
+
+	r, err := NewSDKResource(pkg, resName)
+	if err != nil {
+		return nil, fmt.Errorf("error building resource from AWS Go SDK v2 API model: %w", err)
+	}
+
+	return r, nil
+}
+
 func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
 	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
 		return fmt.Errorf("file (%s) already exists and force is not set", filename)
@@ -158,7 +218,7 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 		return fmt.Errorf("error opening file (%s): %s", filename, err)
 	}
 
-	tplate, err := template.New(templateName).Parse(tmpl)
+	tplate, err := template.New(templateName).Funcs(templateFuncs).Parse(tmpl)
 	if err != nil {
 		return fmt.Errorf("error parsing template: %s", err)
 	}
@@ -169,13 +229,18 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 		return fmt.Errorf("error executing template: %s", err)
 	}
 
-	//contents, err := format.Source(buffer.Bytes())
-	//if err != nil {
-	//	return fmt.Errorf("error formatting generated file: %s", err)
-	//}
+	contents := buffer.Bytes()
+
+	// Code generated from the AWS SDK API model is complete enough to be gofmt'd.
+	if td.SDK != nil && filepath.Ext(filename) == ".go" {
+		contents, err = format.Source(contents)
+		if err != nil {
+			f.Close() // ignore error; formatting error takes precedence
+			return fmt.Errorf("error formatting generated file (%s): %s", filename, err)
+		}
+	}
 
-	//if _, err := f.Write(contents); err != nil {
-	if _, err := f.Write(buffer.Bytes()); err != nil {
+	if _, err := f.Write(contents); err != nil {
 		f.Close() // ignore error; Write error takes precedence
 		return fmt.Errorf("error writing to file (%s): %s", filename, err)
 	}
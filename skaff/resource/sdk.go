@@ -0,0 +1,627 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/awssdk"
+)
+
+// maxNestingDepth limits how deeply nested shapes are turned into nested blocks.
+const maxNestingDepth = 5
+
+// SDKResource is a resource's AWS SDK for Go v2 API model, as used by the resource templates.
+type SDKResource struct {
+	// Operations. ReadOperation is either Get<Resource> or Describe<Resource>.
+	CreateOperation string
+	ReadOperation   string
+	UpdateOperation string
+	DeleteOperation string
+
+	// ReadOutputField is the read operation's output field containing the resource description.
+	// If empty the output structure itself describes the resource.
+	ReadOutputField string
+	// FindOutputType is the type returned by the generated finder, e.g. "awstypes.Cluster".
+	FindOutputType string
+
+	// IDField is the identifier field of the read and delete operations' input, e.g. "ClusterArn".
+	IDField string
+	// CreateOutputID is the expression yielding the identifier from the create operation's output, e.g. "output.Cluster.ClusterArn".
+	CreateOutputID string
+
+	// NotFoundError is the modeled "not found" error, e.g. "awstypes.ResourceNotFoundException".
+	NotFoundError string
+
+	ClientToken bool
+	Tags        bool
+	Status      *SDKStatus
+
+	// Attributes are the resource's top-level attributes, sorted by Terraform name.
+	Attributes []*SDKAttribute
+	// Nested contains the data models of nested blocks.
+	Nested []*SDKNestedObject
+	// Unsupported lists the API members that could not be mapped to the schema.
+	Unsupported []string
+}
+
+// SDKStatus describes a resource's status enumeration and the values used by waiters.
+type SDKStatus struct {
+	// Field is the resource description's status field, e.g. "Status".
+	Field                                             string
+	CreatePending, CreateTarget                       []string
+	UpdatePending, UpdateTarget                       []string
+	DeletePending                                     []string
+	HasCreateWaiter, HasUpdateWaiter, HasDeleteWaiter bool
+}
+
+// SDKAttribute is a single schema attribute or nested block.
+type SDKAttribute struct {
+	GoName string
+	TFName string
+	// ModelType is the attribute's data model type, e.g. "types.String".
+	ModelType string
+	// SchemaType is the schema attribute or block type, e.g. "schema.StringAttribute".
+	SchemaType string
+	// ElementType is the element type of a list or map attribute.
+	ElementType string
+	// Enum is the enumeration type of a string attribute, e.g. "awstypes.AuthType".
+	Enum string
+	// Nested is the nested block's data model.
+	Nested   *SDKNestedObject
+	MaxItems int
+
+	ID              bool
+	Required        bool
+	Optional        bool
+	Computed        bool
+	RequiresReplace bool
+	Updatable       bool
+}
+
+// SDKNestedObject is a nested block's data model.
+type SDKNestedObject struct {
+	Name       string
+	Shape      string
+	Attributes []*SDKAttribute
+}
+
+// IsBlock returns whether the attribute is a nested block.
+func (a *SDKAttribute) IsBlock() bool {
+	return a.Nested != nil
+}
+
+// PlanModifierType returns the attribute's plan modifier package prefix, e.g. "string".
+func (a *SDKAttribute) PlanModifierType() string {
+	t := strings.TrimSuffix(strings.TrimPrefix(a.SchemaType, "schema."), "Attribute")
+	return strings.ToLower(t[:1]) + t[1:]
+}
+
+// PlanModifierInterface returns the attribute's planmodifier interface type, e.g. "String".
+func (a *SDKAttribute) PlanModifierInterface() string {
+	return strings.TrimSuffix(strings.TrimPrefix(a.SchemaType, "schema."), "Attribute")
+}
+
+// Arguments returns the configurable attributes.
+func (r *SDKResource) Arguments() []*SDKAttribute {
+	var args []*SDKAttribute
+
+	for _, a := range r.Attributes {
+		if a.Required || a.Optional {
+			args = append(args, a)
+		}
+	}
+
+	return args
+}
+
+// ComputedAttributes returns the attributes that are not configurable.
+func (r *SDKResource) ComputedAttributes() []*SDKAttribute {
+	var attrs []*SDKAttribute
+
+	for _, a := range r.Attributes {
+		if !a.Required && !a.Optional {
+			attrs = append(attrs, a)
+		}
+	}
+
+	return attrs
+}
+
+// UpdatableAttributes returns the attributes that are updated in-place.
+func (r *SDKResource) UpdatableAttributes() []*SDKAttribute {
+	var attrs []*SDKAttribute
+
+	for _, a := range r.Attributes {
+		if a.Updatable {
+			attrs = append(attrs, a)
+		}
+	}
+
+	return attrs
+}
+
+// IDAttribute returns the identifier attribute.
+func (r *SDKResource) IDAttribute() *SDKAttribute {
+	for _, a := range r.Attributes {
+		if a.ID {
+			return a
+		}
+	}
+
+	return nil
+}
+
+// HasEnums returns whether any attribute is validated against an enumeration.
+func (r *SDKResource) HasEnums() bool {
+	return r.Status != nil || r.anyAttribute(func(a *SDKAttribute) bool { return a.Enum != "" })
+}
+
+// HasBlocks returns whether there are any nested blocks.
+func (r *SDKResource) HasBlocks() bool {
+	return len(r.Nested) > 0
+}
+
+// HasPlanModifiers returns whether the attribute or block has any plan modifiers.
+func (a *SDKAttribute) HasPlanModifiers() bool {
+	if a.ID && !a.Required && !a.Optional {
+		return false
+	}
+
+	if a.IsBlock() {
+		return a.RequiresReplace
+	}
+
+	return a.RequiresReplace || a.Computed
+}
+
+// HasPlanModifier returns whether any attribute or block uses a plan modifier of the specified type, e.g. "string".
+func (r *SDKResource) HasPlanModifier(t string) bool {
+	return r.anyAttribute(func(a *SDKAttribute) bool {
+		if !a.HasPlanModifiers() {
+			return false
+		}
+
+		if a.IsBlock() {
+			return t == "list"
+		}
+
+		return a.PlanModifierType() == t
+	})
+}
+
+// HasPlanModifiers returns whether any attribute or block has plan modifiers.
+func (r *SDKResource) HasPlanModifiers() bool {
+	return r.anyAttribute(func(a *SDKAttribute) bool { return a.HasPlanModifiers() })
+}
+
+// HasValidators returns whether any attribute or block has validators.
+func (r *SDKResource) HasValidators() bool {
+	return r.anyAttribute(func(a *SDKAttribute) bool { return a.Enum != "" || (a.IsBlock() && (a.MaxItems > 0 || a.Required)) })
+}
+
+// HasListValidators returns whether any block has validators.
+func (r *SDKResource) HasListValidators() bool {
+	return r.anyAttribute(func(a *SDKAttribute) bool { return a.IsBlock() && (a.MaxItems > 0 || a.Required) })
+}
+
+// UsesTypes returns whether the generated code references the SDK service package's types sub-package.
+func (r *SDKResource) UsesTypes() bool {
+	return r.NotFoundError != "" || r.HasEnums() || strings.HasPrefix(r.FindOutputType, "awstypes.")
+}
+
+func (r *SDKResource) anyAttribute(f func(*SDKAttribute) bool) bool {
+	for _, a := range r.Attributes {
+		if f(a) {
+			return true
+		}
+	}
+
+	for _, n := range r.Nested {
+		for _, a := range n.Attributes {
+			if f(a) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// NewSDKResource builds the API model of the named resource from an AWS SDK for Go v2 service package.
+func NewSDKResource(pkg *awssdk.Package, resName string) (*SDKResource, error) {
+	r := &SDKResource{}
+
+	r.CreateOperation = firstOperation(pkg, "Create"+resName, "Put"+resName)
+	r.ReadOperation = firstOperation(pkg, "Get"+resName, "Describe"+resName)
+	r.UpdateOperation = firstOperation(pkg, "Update"+resName, "Modify"+resName)
+	r.DeleteOperation = firstOperation(pkg, "Delete"+resName)
+
+	for op, v := range map[string]string{"create": r.CreateOperation, "read": r.ReadOperation, "delete": r.DeleteOperation} {
+		if v == "" {
+			return nil, fmt.Errorf("no %s operation for %s found in %s", op, resName, pkg.ImportPath)
+		}
+	}
+
+	createInput := pkg.Structs[r.CreateOperation+"Input"]
+	createOutput := pkg.Structs[r.CreateOperation+"Output"]
+	readInput := pkg.Structs[r.ReadOperation+"Input"]
+	readOutput := pkg.Structs[r.ReadOperation+"Output"]
+	deleteInput := pkg.Structs[r.DeleteOperation+"Input"]
+	var updateInput *awssdk.Struct
+	if r.UpdateOperation != "" {
+		updateInput = pkg.Structs[r.UpdateOperation+"Input"]
+	}
+
+	// The resource description is either a single shape-valued member of the read operation's output or the output itself.
+	description := readOutput
+	r.FindOutputType = pkg.Name + "." + readOutput.Name
+	if f := singleShapeField(pkg, readOutput); f != nil {
+		r.ReadOutputField = f.Name
+		description = pkg.Structs[strings.TrimPrefix(f.Type, "*")]
+		r.FindOutputType = "aws" + strings.TrimPrefix(f.Type, "*")
+	}
+
+	r.IDField = identifierField(resName, readInput, deleteInput)
+	if r.IDField == "" {
+		return nil, fmt.Errorf("no identifier for %s found in %sInput", resName, r.ReadOperation)
+	}
+
+	switch {
+	case createOutput.Field(r.IDField) != nil:
+		r.CreateOutputID = "output." + r.IDField
+	default:
+		if f := singleShapeField(pkg, createOutput); f != nil && pkg.Structs[strings.TrimPrefix(f.Type, "*")].Field(r.IDField) != nil {
+			r.CreateOutputID = "output." + f.Name + "." + r.IDField
+		}
+	}
+
+	for _, name := range []string{"types.ResourceNotFoundException", "types.NotFoundException"} {
+		if pkg.Errors[name] {
+			r.NotFoundError = "aws" + name
+			break
+		}
+	}
+
+	r.ClientToken = createInput.Field("ClientToken") != nil
+	if f := createInput.Field("Tags"); f != nil && f.Type == "map[string]string" {
+		r.Tags = true
+	}
+
+	r.Status = statusOf(pkg, resName, description)
+
+	g := &sdkModelGenerator{
+		pkg:        pkg,
+		resource:   r,
+		nested:     make(map[string]*SDKNestedObject),
+		inProgress: make(map[string]bool),
+	}
+	g.resourceAttributes(resName, createInput, updateInput, description)
+
+	sort.Slice(r.Nested, func(i, j int) bool {
+		return r.Nested[i].Name < r.Nested[j].Name
+	})
+	sort.Strings(r.Unsupported)
+
+	return r, nil
+}
+
+func firstOperation(pkg *awssdk.Package, names ...string) string {
+	for _, name := range names {
+		if pkg.Operations[name] {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// singleShapeField returns the structure's only shape-valued field, if any.
+func singleShapeField(pkg *awssdk.Package, s *awssdk.Struct) *awssdk.Field {
+	if s == nil || len(s.Fields) != 1 {
+		return nil
+	}
+
+	f := s.Fields[0]
+	if !strings.HasPrefix(f.Type, "*"+awssdk.TypesPackage+".") {
+		return nil
+	}
+
+	if _, ok := pkg.Structs[strings.TrimPrefix(f.Type, "*")]; !ok {
+		return nil
+	}
+
+	return f
+}
+
+// identifierField returns the name of the required string field that identifies the resource.
+func identifierField(resName string, inputs ...*awssdk.Struct) string {
+	candidates := []string{resName + "Identifier", resName + "Arn", resName + "Id", resName + "Name", "Identifier", "Arn", "Id", "Name"}
+
+	for _, input := range inputs {
+		if input == nil {
+			continue
+		}
+
+		for _, name := range candidates {
+			if f := input.Field(name); f != nil && f.Required && f.Type == "*string" {
+				return name
+			}
+		}
+
+		for _, f := range input.Fields {
+			if f.Required && f.Type == "*string" {
+				return f.Name
+			}
+		}
+	}
+
+	return ""
+}
+
+var (
+	statusPendingCreate = []string{"CREATING", "PENDING", "PROVISIONING", "CREATE_IN_PROGRESS"}
+	statusPendingUpdate = []string{"UPDATING", "MODIFYING", "UPDATE_IN_PROGRESS"}
+	statusPendingDelete = []string{"DELETING", "DELETE_IN_PROGRESS"}
+	statusTarget        = []string{"ACTIVE", "AVAILABLE", "CREATED", "READY", "ENABLED", "RUNNING", "COMPLETED", "SUCCEEDED", "IN_SERVICE", "ONLINE", "HEALTHY"}
+)
+
+func statusOf(pkg *awssdk.Package, resName string, description *awssdk.Struct) *SDKStatus {
+	var field *awssdk.Field
+	var enum *awssdk.Enum
+
+	for _, name := range []string{"Status", resName + "Status", "State", resName + "State"} {
+		if f := description.Field(name); f != nil {
+			if e, ok := pkg.Enums[f.Type]; ok && len(e.Values) > 0 {
+				field, enum = f, e
+				break
+			}
+		}
+	}
+
+	if field == nil {
+		return nil
+	}
+
+	status := &SDKStatus{Field: field.Name}
+
+	for _, v := range enum.Values {
+		c := "aws" + v.Const
+		normalized := strings.ToUpper(strings.NewReplacer("-", "_", " ", "_").Replace(v.Value))
+
+		switch {
+		case contains(statusPendingCreate, normalized):
+			status.CreatePending = append(status.CreatePending, c)
+		case contains(statusPendingUpdate, normalized):
+			status.UpdatePending = append(status.UpdatePending, c)
+		case contains(statusPendingDelete, normalized):
+			status.DeletePending = append(status.DeletePending, c)
+		case contains(statusTarget, normalized):
+			status.CreateTarget = append(status.CreateTarget, c)
+			status.UpdateTarget = append(status.UpdateTarget, c)
+		}
+	}
+
+	status.HasCreateWaiter = len(status.CreatePending) > 0 && len(status.CreateTarget) > 0
+	status.HasUpdateWaiter = len(status.UpdatePending) > 0 && len(status.UpdateTarget) > 0
+	status.HasDeleteWaiter = len(status.DeletePending) > 0
+
+	if !status.HasCreateWaiter && !status.HasUpdateWaiter && !status.HasDeleteWaiter {
+		return nil
+	}
+
+	return status
+}
+
+func contains(l []string, v string) bool {
+	for _, e := range l {
+		if e == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+type sdkModelGenerator struct {
+	pkg      *awssdk.Package
+	resource *SDKResource
+	nested   map[string]*SDKNestedObject
+	// inProgress contains the shapes currently being mapped, for detecting recursive shapes.
+	inProgress map[string]bool
+}
+
+// resourceAttributes maps the create input, update input and resource description to top-level attributes.
+func (g *sdkModelGenerator) resourceAttributes(resName string, create, update, description *awssdk.Struct) {
+	r := g.resource
+	seen := make(map[string]*SDKAttribute)
+
+	skip := func(name string) bool {
+		switch name {
+		case "ClientToken", "Tags":
+			return true
+		}
+		return r.Status != nil && name == r.Status.Field
+	}
+
+	for _, f := range create.Fields {
+		if skip(f.Name) {
+			continue
+		}
+
+		a := g.attribute("", f, 0)
+		if a == nil {
+			continue
+		}
+
+		a.TFName = topLevelName(resName, f.Name)
+		a.Required = f.Required
+		a.Optional = !f.Required
+		a.Computed = a.Optional && !a.IsBlock() && description.Field(f.Name) != nil
+		a.Updatable = update.Field(f.Name) != nil && f.Name != r.IDField
+		a.RequiresReplace = !a.Updatable
+
+		seen[f.Name] = a
+	}
+
+	for _, f := range description.Fields {
+		if skip(f.Name) || seen[f.Name] != nil {
+			continue
+		}
+
+		// Computed nested blocks can't be represented as blocks.
+		if isShape(g.pkg, f.Type) {
+			r.Unsupported = append(r.Unsupported, f.Name)
+			continue
+		}
+
+		a := g.attribute("", f, 0)
+		if a == nil {
+			continue
+		}
+
+		a.TFName = topLevelName(resName, f.Name)
+		a.Computed = true
+
+		seen[f.Name] = a
+	}
+
+	if a, ok := seen[r.IDField]; ok {
+		a.ID = true
+		a.TFName = "id"
+	} else {
+		seen[r.IDField] = &SDKAttribute{
+			GoName:     r.IDField,
+			TFName:     "id",
+			ModelType:  "types.String",
+			SchemaType: "schema.StringAttribute",
+			ID:         true,
+			Computed:   true,
+		}
+	}
+
+	for _, a := range seen {
+		r.Attributes = append(r.Attributes, a)
+	}
+
+	sort.Slice(r.Attributes, func(i, j int) bool {
+		return r.Attributes[i].TFName < r.Attributes[j].TFName
+	})
+}
+
+// attribute maps a single API member to an attribute. nil is returned for unsupported members.
+func (g *sdkModelGenerator) attribute(path string, f *awssdk.Field, depth int) *SDKAttribute {
+	a := &SDKAttribute{
+		GoName: f.Name,
+		TFName: ToSnakeCase(f.Name, ""),
+	}
+
+	t := strings.TrimPrefix(f.Type, "*")
+
+	switch {
+	case t == "string":
+		a.ModelType, a.SchemaType = "types.String", "schema.StringAttribute"
+	case t == "bool":
+		a.ModelType, a.SchemaType = "types.Bool", "schema.BoolAttribute"
+	case t == "int32" || t == "int64" || t == "int":
+		a.ModelType, a.SchemaType = "types.Int64", "schema.Int64Attribute"
+	case t == "float32" || t == "float64":
+		a.ModelType, a.SchemaType = "types.Float64", "schema.Float64Attribute"
+	case g.pkg.Enums[t] != nil:
+		a.ModelType, a.SchemaType, a.Enum = "types.String", "schema.StringAttribute", "aws"+t
+	case f.Type == "[]string" || (strings.HasPrefix(f.Type, "[]") && g.pkg.Enums[strings.TrimPrefix(f.Type, "[]")] != nil):
+		a.ModelType, a.SchemaType, a.ElementType = "types.List", "schema.ListAttribute", "types.StringType"
+	case f.Type == "map[string]string":
+		a.ModelType, a.SchemaType, a.ElementType = "types.Map", "schema.MapAttribute", "types.StringType"
+	case isShape(g.pkg, f.Type) && depth < maxNestingDepth:
+		shape := strings.TrimPrefix(strings.TrimPrefix(f.Type, "[]"), "*")
+		nested := g.nestedObject(shape, depth+1)
+		if nested == nil {
+			g.resource.Unsupported = append(g.resource.Unsupported, joinPath(path, f.Name))
+			return nil
+		}
+
+		a.Nested = nested
+		a.ModelType = fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", nested.Name)
+		a.SchemaType = "schema.ListNestedBlock"
+		if strings.HasPrefix(f.Type, "*") {
+			a.MaxItems = 1
+		}
+	default:
+		g.resource.Unsupported = append(g.resource.Unsupported, joinPath(path, f.Name))
+		return nil
+	}
+
+	return a
+}
+
+func (g *sdkModelGenerator) nestedObject(shape string, depth int) *SDKNestedObject {
+	if n, ok := g.nested[shape]; ok {
+		return n
+	}
+
+	// Recursive shapes can't be represented as nested blocks.
+	if g.inProgress[shape] {
+		return nil
+	}
+
+	g.inProgress[shape] = true
+	defer delete(g.inProgress, shape)
+
+	s := g.pkg.Structs[shape]
+	name := strings.TrimPrefix(shape, awssdk.TypesPackage+".")
+	n := &SDKNestedObject{
+		Name:  strings.ToLower(name[:1]) + name[1:] + "Model",
+		Shape: shape,
+	}
+
+	for _, f := range s.Fields {
+		a := g.attribute(name, f, depth)
+		if a == nil {
+			continue
+		}
+
+		a.Required = f.Required
+		a.Optional = !f.Required
+
+		n.Attributes = append(n.Attributes, a)
+	}
+
+	if len(n.Attributes) == 0 {
+		return nil
+	}
+
+	sort.Slice(n.Attributes, func(i, j int) bool {
+		return n.Attributes[i].TFName < n.Attributes[j].TFName
+	})
+
+	g.nested[shape] = n
+	g.resource.Nested = append(g.resource.Nested, n)
+
+	return n
+}
+
+func isShape(pkg *awssdk.Package, t string) bool {
+	_, ok := pkg.Structs[strings.TrimPrefix(strings.TrimPrefix(t, "[]"), "*")]
+	return ok && strings.Contains(t, awssdk.TypesPackage+".")
+}
+
+// topLevelName returns the Terraform name of a top-level attribute, dropping any resource name prefix (e.g. ClusterName => name).
+func topLevelName(resName, goName string) string {
+	if v := strings.TrimPrefix(goName, resName); v != goName && v != "" && v[:1] == strings.ToUpper(v[:1]) {
+		return ToSnakeCase(v, "")
+	}
+
+	return ToSnakeCase(goName, "")
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
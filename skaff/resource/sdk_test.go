@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	"go/format"
+	"reflect"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/awssdk"
+)
+
+func testSDKResource(t *testing.T) *SDKResource {
+	t.Helper()
+
+	pkg, err := awssdk.Load("../awssdk/testdata/widgets", "example.com/widgets")
+	if err != nil {
+		t.Fatalf("loading package: %s", err)
+	}
+
+	r, err := NewSDKResource(pkg, "Widget")
+	if err != nil {
+		t.Fatalf("building resource: %s", err)
+	}
+
+	return r
+}
+
+func TestNewSDKResource(t *testing.T) {
+	r := testSDKResource(t)
+
+	if got, expected := []string{r.CreateOperation, r.ReadOperation, r.UpdateOperation, r.DeleteOperation}, []string{"CreateWidget", "GetWidget", "UpdateWidget", "DeleteWidget"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got operations %v, expected %v", got, expected)
+	}
+
+	if got, expected := r.FindOutputType, "awstypes.Widget"; got != expected {
+		t.Errorf("got find output type %s, expected %s", got, expected)
+	}
+
+	if got, expected := r.IDField, "WidgetArn"; got != expected {
+		t.Errorf("got ID field %s, expected %s", got, expected)
+	}
+
+	if got, expected := r.CreateOutputID, "output.Widget.WidgetArn"; got != expected {
+		t.Errorf("got create output ID %s, expected %s", got, expected)
+	}
+
+	if got, expected := r.NotFoundError, "awstypes.ResourceNotFoundException"; got != expected {
+		t.Errorf("got not found error %s, expected %s", got, expected)
+	}
+
+	if !r.ClientToken || !r.Tags {
+		t.Errorf("got client token %t and tags %t, expected both", r.ClientToken, r.Tags)
+	}
+
+	expectedStatus := &SDKStatus{
+		Field:           "Status",
+		CreatePending:   []string{"awstypes.StatusCreating"},
+		CreateTarget:    []string{"awstypes.StatusActive"},
+		UpdatePending:   []string{"awstypes.StatusUpdating"},
+		UpdateTarget:    []string{"awstypes.StatusActive"},
+		DeletePending:   []string{"awstypes.StatusDeleting"},
+		HasCreateWaiter: true,
+		HasUpdateWaiter: true,
+		HasDeleteWaiter: true,
+	}
+	if !reflect.DeepEqual(r.Status, expectedStatus) {
+		t.Errorf("got status %#v, expected %#v", r.Status, expectedStatus)
+	}
+
+	if got, expected := r.Unsupported, []string{"Configuration.Parent", "Dimensions", "Node.Child"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got unsupported %v, expected %v", got, expected)
+	}
+
+	testCases := []struct {
+		TestName string
+		Expected SDKAttribute
+	}{
+		{
+			TestName: "configuration",
+			Expected: SDKAttribute{GoName: "Configuration", TFName: "configuration", ModelType: "fwtypes.ListNestedObjectValueOf[configurationModel]", SchemaType: "schema.ListNestedBlock", MaxItems: 1, Optional: true, RequiresReplace: true},
+		},
+		{
+			TestName: "create_time",
+			Expected: SDKAttribute{GoName: "CreateTime", TFName: "create_time", ModelType: "types.String", SchemaType: "schema.StringAttribute", Computed: true},
+		},
+		{
+			TestName: "id",
+			Expected: SDKAttribute{GoName: "WidgetArn", TFName: "id", ModelType: "types.String", SchemaType: "schema.StringAttribute", ID: true, Computed: true},
+		},
+		{
+			TestName: "kms_key_id",
+			Expected: SDKAttribute{GoName: "KmsKeyId", TFName: "kms_key_id", ModelType: "types.String", SchemaType: "schema.StringAttribute", Optional: true, Computed: true, RequiresReplace: true},
+		},
+		{
+			TestName: "name",
+			Expected: SDKAttribute{GoName: "WidgetName", TFName: "name", ModelType: "types.String", SchemaType: "schema.StringAttribute", Required: true, RequiresReplace: true},
+		},
+		{
+			TestName: "size",
+			Expected: SDKAttribute{GoName: "Size", TFName: "size", ModelType: "types.Int64", SchemaType: "schema.Int64Attribute", Required: true, Updatable: true},
+		},
+		{
+			TestName: "type",
+			Expected: SDKAttribute{GoName: "Type", TFName: "type", ModelType: "types.String", SchemaType: "schema.StringAttribute", Enum: "awstypes.WidgetType", Optional: true, Computed: true, RequiresReplace: true},
+		},
+	}
+
+	if got, expected := len(r.Attributes), len(testCases); got != expected {
+		t.Fatalf("got %d attributes, expected %d", got, expected)
+	}
+
+	for i, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := *r.Attributes[i]
+			got.Nested = nil
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got attribute %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+
+	if got, expected := len(r.Nested), 1; got != expected {
+		t.Fatalf("got %d nested objects, expected %d", got, expected)
+	}
+
+	var got []string
+	for _, a := range r.Nested[0].Attributes {
+		got = append(got, a.TFName)
+	}
+	if expected := []string{"enabled", "labels"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got nested attributes %v, expected %v", got, expected)
+	}
+}
+
+func TestSDKTemplates(t *testing.T) {
+	td := TemplateData{
+		Resource:             "Widget",
+		ResourceLower:        "widget",
+		ResourceSnake:        "widget",
+		HumanFriendlyService: "Widgets",
+		IncludeComments:      true,
+		IncludeTags:          true,
+		ServicePackage:       "widgets",
+		Service:              "Widgets",
+		ServiceLower:         "widgets",
+		AWSServiceName:       "Amazon Widgets",
+		AWSGoSDKV2:           true,
+		PluginFramework:      true,
+		HumanResourceName:    "Widget",
+		ProviderResourceName: "aws_widgets_widget",
+		SDK:                  testSDKResource(t),
+	}
+
+	testCases := []struct {
+		TestName string
+		Template string
+		Go       bool
+	}{
+		{
+			TestName: "resource",
+			Template: resourceFrameworkSDKTmpl,
+			Go:       true,
+		},
+		{
+			TestName: "resource test",
+			Template: resourceTestSDKTmpl,
+			Go:       true,
+		},
+		{
+			TestName: "website doc",
+			Template: websiteTmpl,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			tmpl, err := template.New(testCase.TestName).Funcs(templateFuncs).Parse(testCase.Template)
+			if err != nil {
+				t.Fatalf("parsing template: %s", err)
+			}
+
+			var buffer bytes.Buffer
+			if err := tmpl.Execute(&buffer, td); err != nil {
+				t.Fatalf("executing template: %s", err)
+			}
+
+			if testCase.Go {
+				if _, err := format.Source(buffer.Bytes()); err != nil {
+					t.Errorf("formatting generated source: %s\n%s", err, buffer.String())
+				}
+			}
+		})
+	}
+}